@@ -25,12 +25,12 @@ resource "shodan_alert" "production_network" {
   ]
   
   triggers = [
-    "ai",
-    "malware",
-    "vulnerable",
-    "new_service",
-    "open_database",
-    "ssl_expired"
+    { name = "ai" },
+    { name = "malware" },
+    { name = "vulnerable" },
+    { name = "new_service" },
+    { name = "open_database" },
+    { name = "ssl_expired" },
   ]
   
   notifiers = [
@@ -54,11 +54,11 @@ resource "shodan_alert" "dmz_network" {
   ]
   
   triggers = [
-    "ai",
-    "malware",
-    "vulnerable",
-    "internet_scanner",
-    "iot"
+    { name = "ai" },
+    { name = "malware" },
+    { name = "vulnerable" },
+    { name = "internet_scanner" },
+    { name = "iot" },
   ]
   
   notifiers = [
@@ -3,18 +3,26 @@ package main
 import (
 	"context"
 	"net/http"
+	"os"
 
 	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan"
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &ShodanProvider{}
+	_ provider.Provider                       = &ShodanProvider{}
+	_ provider.ProviderWithFunctions          = &ShodanProvider{}
+	_ provider.ProviderWithEphemeralResources = &ShodanProvider{}
 )
 
 // ShodanProvider is the provider implementation.
@@ -29,6 +37,7 @@ type ShodanProvider struct {
 type ShodanProviderModel struct {
 	ApiKey          types.String `tfsdk:"api_key"`
 	RequestInterval types.Int64  `tfsdk:"request_interval"`
+	Strict          types.Bool   `tfsdk:"strict"`
 }
 
 func (p *ShodanProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -41,12 +50,22 @@ func (p *ShodanProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 		Description: "Interact with Shodan API to manage network alerts and monitoring.",
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				Description: "Shodan API key for authentication. Can also be set via SHODAN_API_KEY environment variable.",
-				Required:    true,
+				Description: "Shodan API key for authentication. Can also be set via SHODAN_API_KEY environment variable. Only the shodan_internetdb, shodan_cve and shodan_cves data sources can be used without one.",
+				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					validators.NotBlank(),
+				},
 			},
 			"request_interval": schema.Int64Attribute{
-				Description: "Interval between API requests in seconds. Defaults to 2 if not specified (1 request per 2 seconds).",
+				Description: "Interval between API requests in seconds, between 1 and 3600. Defaults to 2 if not specified (1 request per 2 seconds).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					validators.RequestInterval(),
+				},
+			},
+			"strict": schema.BoolAttribute{
+				Description: "Fail the apply when a trigger or notifier cannot be attached to an alert instead of emitting a warning. Can be overridden per resource. Defaults to false.",
 				Optional:    true,
 			},
 		},
@@ -62,30 +81,41 @@ func (p *ShodanProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Configuration values are now available.
-	// if config.ApiKey.IsNull() { /* ... */ }
+	if config.ApiKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Unknown Shodan API key",
+			"The provider cannot be configured because the API key depends on a value that is not known until apply. "+
+				"Set api_key to a static value or use the SHODAN_API_KEY environment variable.",
+		)
+		return
+	}
 
-	// Get request interval from config, default to 2 seconds if not specified
-	requestInterval := int64(2)
-	if !config.RequestInterval.IsNull() {
-		requestInterval = config.RequestInterval.ValueInt64()
+	// Fall back to the environment when api_key is not configured. A missing
+	// key is reported by the resources and data sources that need one.
+	apiKey := os.Getenv("SHODAN_API_KEY")
+	if !config.ApiKey.IsNull() {
+		apiKey = config.ApiKey.ValueString()
 	}
 
-	// Example client configuration for data sources and resources
-	client := &shodan.ShodanClient{
-		ApiKey:     config.ApiKey.ValueString(),
-		BaseURL:    "https://api.shodan.io",
-		HTTPClient: shodan.NewRateLimitedHTTPClient(&http.Client{}, requestInterval),
+	// Client configuration for data sources and resources, overriding the
+	// request interval only if one is configured
+	client := shodan.NewShodanClient(apiKey)
+	client.Strict = config.Strict.ValueBool()
+	if !config.RequestInterval.IsNull() {
+		client.HTTPClient = shodan.NewRateLimitedHTTPClient(&http.Client{}, config.RequestInterval.ValueInt64())
 	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *ShodanProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		shodan.NewShodanAlertResource,
 		shodan.NewShodanDomainResource,
+		shodan.NewShodanAlertTriggerIgnoreResource,
 	}
 }
 
@@ -93,6 +123,37 @@ func (p *ShodanProvider) DataSources(ctx context.Context) []func() datasource.Da
 	return []func() datasource.DataSource{
 		shodan.NewShodanAlertDataSource,
 		shodan.NewShodanDomainDataSource,
+		shodan.NewShodanAlertTriggersDataSource,
+		shodan.NewShodanDNSResolveDataSource,
+		shodan.NewShodanDNSReverseDataSource,
+		shodan.NewShodanInternetDBDataSource,
+		shodan.NewShodanCVEDataSource,
+		shodan.NewShodanCVEsDataSource,
+		shodan.NewShodanExploitsDataSource,
+		shodan.NewShodanPortsDataSource,
+		shodan.NewShodanProtocolsDataSource,
+		shodan.NewShodanSearchFiltersDataSource,
+		shodan.NewShodanSearchFacetsDataSource,
+		shodan.NewShodanQueryTokensDataSource,
+		shodan.NewShodanQueryDirectoryDataSource,
+		shodan.NewShodanQueryTagsDataSource,
+	}
+}
+
+func (p *ShodanProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		shodan.NewShodanHostEphemeralResource,
+		shodan.NewShodanSearchEphemeralResource,
+	}
+}
+
+func (p *ShodanProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		shodan.NewShodanCIDRAddressCountFunction,
+		shodan.NewShodanCIDRMergeFunction,
+		shodan.NewShodanIsPublicIPFunction,
+		shodan.NewShodanBuildQueryFunction,
+		shodan.NewShodanParseDomainAlertNameFunction,
 	}
 }
 
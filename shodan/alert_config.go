@@ -0,0 +1,390 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// attachError describes a trigger or notifier that could not be attached to or
+// removed from an alert
+type attachError struct {
+	kind     string
+	id       string
+	err      error
+	removing bool
+}
+
+func (e *attachError) Error() string {
+	if e.removing {
+		return fmt.Sprintf("could not remove %s %s: %s", e.kind, e.id, e.err.Error())
+	}
+	return fmt.Sprintf("could not add %s %s: %s", e.kind, e.id, e.err.Error())
+}
+
+// addTriggers enables each trigger on the alert and returns the ones that failed
+func addTriggers(client *ShodanClient, alertID string, triggers []string) []*attachError {
+	var errs []*attachError
+	for _, trigger := range triggers {
+		if err := client.AddTrigger(alertID, trigger); err != nil {
+			errs = append(errs, &attachError{kind: "trigger", id: trigger, err: err})
+		}
+	}
+	return errs
+}
+
+// removeTriggers disables each trigger on the alert and returns the ones that failed
+func removeTriggers(client *ShodanClient, alertID string, triggers []string) []*attachError {
+	var errs []*attachError
+	for _, trigger := range triggers {
+		if err := client.RemoveTrigger(alertID, trigger); err != nil {
+			errs = append(errs, &attachError{kind: "trigger", id: trigger, err: err, removing: true})
+		}
+	}
+	return errs
+}
+
+// addNotifiers attaches each notifier to the alert and returns the ones that failed.
+// kind describes the notifiers in error messages (e.g. "Slack notifier").
+func addNotifiers(client *ShodanClient, alertID, kind string, notifierIDs []string) []*attachError {
+	var errs []*attachError
+	for _, notifierID := range notifierIDs {
+		if err := client.AddNotifier(alertID, notifierID); err != nil {
+			errs = append(errs, &attachError{kind: kind, id: notifierID, err: err})
+		}
+	}
+	return errs
+}
+
+// removeNotifiers detaches each notifier from the alert and returns the ones that failed
+func removeNotifiers(client *ShodanClient, alertID, kind string, notifierIDs []string) []*attachError {
+	var errs []*attachError
+	for _, notifierID := range notifierIDs {
+		if err := client.RemoveNotifier(alertID, notifierID); err != nil {
+			errs = append(errs, &attachError{kind: kind, id: notifierID, err: err, removing: true})
+		}
+	}
+	return errs
+}
+
+// failedIDs returns the IDs listed in errs
+func failedIDs(errs []*attachError) []string {
+	ids := make([]string, len(errs))
+	for i, err := range errs {
+		ids[i] = err.id
+	}
+	return ids
+}
+
+// failedValues returns the IDs listed in errs as values, or nil if there are none
+func failedValues(errs []*attachError) []types.String {
+	var values []types.String
+	for _, err := range errs {
+		values = append(values, types.StringValue(err.id))
+	}
+	return values
+}
+
+// slackNotifierProvider is the provider of notifiers that post to Slack
+const slackNotifierProvider = "slack"
+
+// splitNotifiers sorts the notifiers attached to an alert into plain and Slack
+// notifiers. A notifier stays in the attribute it was previously recorded in;
+// any other is classified by its provider.
+func splitNotifiers(alert *AlertResponse, priorNotifiers, priorSlackNotifiers []string) ([]string, []string) {
+	var notifiers, slackNotifiers []string
+	for _, notifier := range alert.Notifiers {
+		switch {
+		case slices.Contains(priorSlackNotifiers, notifier.ID):
+			slackNotifiers = append(slackNotifiers, notifier.ID)
+		case slices.Contains(priorNotifiers, notifier.ID):
+			notifiers = append(notifiers, notifier.ID)
+		case notifier.Provider == slackNotifierProvider:
+			slackNotifiers = append(slackNotifiers, notifier.ID)
+		default:
+			notifiers = append(notifiers, notifier.ID)
+		}
+	}
+	return notifiers, slackNotifiers
+}
+
+// withoutFailed returns the IDs that are not listed in errs
+func withoutFailed(ids []string, errs []*attachError) []string {
+	var attached []string
+	for _, id := range ids {
+		if !slices.ContainsFunc(errs, func(e *attachError) bool { return e.id == id }) {
+			attached = append(attached, id)
+		}
+	}
+	return attached
+}
+
+// isStrict returns the resource strict setting, falling back to the provider default
+func isStrict(setting types.Bool, client *ShodanClient) bool {
+	if !setting.IsNull() && !setting.IsUnknown() {
+		return setting.ValueBool()
+	}
+	return client != nil && client.Strict
+}
+
+// reportAttachFailures surfaces failed trigger and notifier calls for an alert.
+// In strict mode they become a single error; the caller is then expected to
+// record what is actually attached in state. Otherwise each failure is a
+// warning and the planned values are kept. During create the outcome is
+// explained by the create transaction instead.
+func reportAttachFailures(diags *diag.Diagnostics, alertID string, strict bool, errs []error, creating bool) {
+	if len(errs) == 0 {
+		return
+	}
+
+	if !strict {
+		for _, err := range errs {
+			diags.AddWarning(
+				"Alert partially configured",
+				fmt.Sprintf("Alert %s: %s. Set strict = true to fail the apply instead.", alertID, err.Error()),
+			)
+		}
+		return
+	}
+
+	details := make([]string, len(errs))
+	for i, err := range errs {
+		details[i] = "  - " + err.Error()
+	}
+
+	detail := fmt.Sprintf("Alert %s could not be fully configured:\n%s", alertID, strings.Join(details, "\n"))
+	if !creating {
+		detail += "\n\nState records the triggers and notifiers actually attached to the alert, so the next apply will retry the rest."
+	}
+
+	diags.AddError("Alert partially configured", detail)
+}
+
+// reportDetachFailures surfaces failed calls while disabling an alert. In
+// strict mode they become a single error and the caller is expected to keep
+// the alert recorded as enabled so the next apply retries.
+func reportDetachFailures(diags *diag.Diagnostics, alertID string, strict bool, errs []error) {
+	if len(errs) == 0 {
+		return
+	}
+
+	if !strict {
+		for _, err := range errs {
+			diags.AddWarning(
+				"Alert partially disabled",
+				fmt.Sprintf("Alert %s: %s. Set strict = true to fail the apply instead.", alertID, err.Error()),
+			)
+		}
+		return
+	}
+
+	details := make([]string, len(errs))
+	for i, err := range errs {
+		details[i] = "  - " + err.Error()
+	}
+
+	diags.AddError(
+		"Alert partially disabled",
+		fmt.Sprintf("Alert %s could not be fully disabled:\n%s\n\nThe alert is still recorded as enabled with what remains attached, so the next apply will retry.", alertID, strings.Join(details, "\n")),
+	)
+}
+
+// attachErrors converts attach failures into plain errors for reporting
+func attachErrors(errs ...[]*attachError) []error {
+	var result []error
+	for _, group := range errs {
+		for _, err := range group {
+			result = append(result, err)
+		}
+	}
+	return result
+}
+
+// stringSetValue converts IDs into a set value, or null if there are none
+func stringSetValue(ids []string) types.Set {
+	if len(ids) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	values := make([]attr.Value, len(ids))
+	for i, id := range ids {
+		values[i] = types.StringValue(id)
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+// setStrings returns the strings in a set, or nil if it is null or unknown
+func setStrings(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	var values []string
+	if !set.IsNull() && !set.IsUnknown() {
+		diags.Append(set.ElementsAs(ctx, &values, false)...)
+	}
+	return values
+}
+
+// addedStrings returns the planned strings and those not present in the prior value
+func addedStrings(ctx context.Context, prior, planned types.Set, diags *diag.Diagnostics) ([]string, []string) {
+	before := setStrings(ctx, prior, diags)
+	after := setStrings(ctx, planned, diags)
+
+	var added []string
+	for _, id := range after {
+		if !slices.Contains(before, id) {
+			added = append(added, id)
+		}
+	}
+	return after, added
+}
+
+// removedStrings returns the prior strings missing from the planned value. An
+// unknown planned value is computed from the alert, so nothing is removed.
+func removedStrings(ctx context.Context, prior, planned types.Set, diags *diag.Diagnostics) []string {
+	if planned.IsUnknown() {
+		return nil
+	}
+
+	before := setStrings(ctx, prior, diags)
+	after := setStrings(ctx, planned, diags)
+
+	var removed []string
+	for _, id := range before {
+		if !slices.Contains(after, id) {
+			removed = append(removed, id)
+		}
+	}
+	return removed
+}
+
+// stringsNotIn returns the values in planned that are not in prior
+func stringsNotIn(planned, prior []types.String) []string {
+	var result []string
+	for _, value := range planned {
+		if !slices.ContainsFunc(prior, func(p types.String) bool { return p.Equal(value) }) {
+			result = append(result, value.ValueString())
+		}
+	}
+	return result
+}
+
+// valueStrings converts values into plain strings
+func valueStrings(values []types.String) []string {
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = value.ValueString()
+	}
+	return result
+}
+
+// stringValues converts plain strings into values, returning an empty slice
+// rather than nil so computed lists are never null
+func stringValues(values []string) []types.String {
+	result := make([]types.String, len(values))
+	for i, value := range values {
+		result[i] = types.StringValue(value)
+	}
+	return result
+}
+
+// setElements converts strings into the elements of a set attribute, or nil
+// if there are none so the set is null
+func setElements(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+	return stringValues(values)
+}
+
+// withoutFailedValues removes values listed in errs, keeping nil if nothing remains
+func withoutFailedValues(values []types.String, errs []*attachError) []types.String {
+	if len(errs) == 0 {
+		return values
+	}
+
+	var attached []types.String
+	for _, value := range values {
+		if !slices.ContainsFunc(errs, func(e *attachError) bool { return e.id == value.ValueString() }) {
+			attached = append(attached, value)
+		}
+	}
+	return attached
+}
+
+// uniqueValues removes repeated values, keeping the first occurrence
+func uniqueValues(values []types.String) []types.String {
+	var unique []types.String
+	for _, value := range values {
+		if !slices.ContainsFunc(unique, func(u types.String) bool { return u.Equal(value) }) {
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+// optionalString returns a string value, or null if it is empty
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// alertMetadata collects the description and tags that are stored in the alert name
+func alertMetadata(ctx context.Context, description types.String, tags types.Set, diags *diag.Diagnostics) AlertMetadata {
+	meta := AlertMetadata{Description: description.ValueString()}
+	if !tags.IsNull() && !tags.IsUnknown() {
+		diags.Append(tags.ElementsAs(ctx, &meta.Tags, false)...)
+	}
+	return meta
+}
+
+// metadataKnown reports whether the description and tags are known, so the
+// alert name they are stored in can be built during plan
+func metadataKnown(description types.String, tags types.Set) bool {
+	if description.IsUnknown() || tags.IsUnknown() {
+		return false
+	}
+	return !slices.ContainsFunc(tags.Elements(), attr.Value.IsUnknown)
+}
+
+// validateAlertNameLength checks the full alert name sent to Shodan, including
+// any domain prefix and metadata suffix, against the longest name accepted
+func validateAlertNameLength(alertName string, attrPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if length := utf8.RuneCountInString(alertName); length > validators.MaxAlertNameLength {
+		diags.AddAttributeError(
+			attrPath,
+			"Alert name too long",
+			fmt.Sprintf("The alert name sent to Shodan, %q, is %d characters long including its generated prefix and suffix, but at most %d are accepted. Shorten the name, description or tags.", alertName, length, validators.MaxAlertNameLength),
+		)
+	}
+
+	return diags
+}
+
+// metadataDescription returns the description stored in an alert name. Alerts
+// created before descriptions were stored have no metadata, so the prior value
+// is kept rather than planning a replacement.
+func metadataDescription(meta AlertMetadata, prior types.String) types.String {
+	if meta.IsEmpty() {
+		return prior
+	}
+	return optionalString(meta.Description)
+}
+
+// metadataTags returns the tags stored in an alert name, keeping the prior value
+// for alerts that have no metadata like metadataDescription.
+func metadataTags(meta AlertMetadata, prior types.Set) types.Set {
+	if meta.IsEmpty() {
+		return prior
+	}
+	return stringSetValue(meta.Tags)
+}
@@ -0,0 +1,21 @@
+package shodan
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// missingAPIKey reports an error if the provider was configured without an API
+// key. Every endpoint except InternetDB and CVEDB requires one, so only the
+// data sources backed by those APIs skip this check.
+func missingAPIKey(client *ShodanClient, diags *diag.Diagnostics) bool {
+	if client.ApiKey != "" {
+		return false
+	}
+
+	diags.AddError(
+		"Missing Shodan API key",
+		"The provider was configured without an API key. Set api_key in the provider configuration or the SHODAN_API_KEY environment variable. "+
+			"Only the shodan_internetdb, shodan_cve and shodan_cves data sources can be used without an API key.",
+	)
+	return true
+}
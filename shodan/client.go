@@ -3,17 +3,51 @@ package shodan
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
+// domainAlertPrefix is prepended to the name of every domain monitoring alert
+const domainAlertPrefix = "__domain: "
+
+// ErrAlertNotFound is returned when an alert doesn't exist on Shodan, such as
+// after it expired or was deleted outside Terraform
+var ErrAlertNotFound = errors.New("alert not found")
+
 // ShodanClient represents a client for interacting with the Shodan API
 type ShodanClient struct {
 	ApiKey     string
 	BaseURL    string
 	HTTPClient *RateLimitedHTTPClient
+
+	// ExploitsURL is the base URL of the Exploits API. It uses the same API
+	// key as the main API, so requests share HTTPClient and its rate limit.
+	ExploitsURL string
+
+	// InternetDBURL is the base URL of the free InternetDB API. It needs no API
+	// key and has its own rate limit, so requests go through a separate client.
+	InternetDBURL        string
+	InternetDBHTTPClient *RateLimitedHTTPClient
+
+	// CVEDBURL is the base URL of the free CVEDB API, which like InternetDB
+	// needs no API key and is rate limited separately
+	CVEDBURL        string
+	CVEDBHTTPClient *RateLimitedHTTPClient
+
+	// Strict makes resources fail when triggers or notifiers cannot be attached
+	Strict bool
+
+	// cache holds responses from reference endpoints for the life of the provider process
+	cache sync.Map
 }
 
 // NewShodanClient creates a new Shodan API client
@@ -22,15 +56,29 @@ func NewShodanClient(apiKey string) *ShodanClient {
 		ApiKey:     apiKey,
 		BaseURL:    "https://api.shodan.io",
 		HTTPClient: NewRateLimitedHTTPClient(&http.Client{}, 2), // Default to 2 seconds between requests
+
+		ExploitsURL: "https://exploits.shodan.io",
+
+		// InternetDB and CVEDB have their own rate limits, so they do not share
+		// the spacing configured for the main API
+		InternetDBURL:        "https://internetdb.shodan.io",
+		InternetDBHTTPClient: NewRateLimitedHTTPClient(&http.Client{}, 1),
+
+		CVEDBURL:        "https://cvedb.shodan.io",
+		CVEDBHTTPClient: NewRateLimitedHTTPClient(&http.Client{}, 1),
 	}
 }
 
-// CreateAlert creates a new Shodan alert
-func (c *ShodanClient) CreateAlert(name string, filters map[string]interface{}) (*AlertResponse, error) {
+// CreateAlert creates a new Shodan alert. A positive expires value sets the
+// number of seconds until the alert expires.
+func (c *ShodanClient) CreateAlert(name string, filters map[string]interface{}, expires int64) (*AlertResponse, error) {
 	payload := map[string]interface{}{
 		"name":    name,
 		"filters": filters,
 	}
+	if expires > 0 {
+		payload["expires"] = expires
+	}
 
 	jsonData, err := json.Marshal(payload)
 	if err != nil {
@@ -89,6 +137,47 @@ func (c *ShodanClient) AddTrigger(alertID, trigger string) error {
 	return nil
 }
 
+// RemoveTrigger disables a trigger on an existing alert
+func (c *ShodanClient) RemoveTrigger(alertID, trigger string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/shodan/alert/%s/trigger/%s?key=%s", c.BaseURL, alertID, trigger, c.ApiKey), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doJSON(req, nil)
+}
+
+// ListTriggers retrieves the catalogue of available alert triggers. The
+// catalogue is cached for the life of the provider process.
+func (c *ShodanClient) ListTriggers() ([]AlertTrigger, error) {
+	var triggers []AlertTrigger
+	if err := c.cachedJSON("/shodan/alert/triggers", nil, &triggers); err != nil {
+		return nil, err
+	}
+
+	return triggers, nil
+}
+
+// AddTriggerIgnore whitelists a service (ip:port) so it no longer fires the given trigger
+func (c *ShodanClient) AddTriggerIgnore(alertID, trigger, service string) error {
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/shodan/alert/%s/trigger/%s/ignore/%s?key=%s", c.BaseURL, alertID, trigger, service, c.ApiKey), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doJSON(req, nil)
+}
+
+// RemoveTriggerIgnore removes a service (ip:port) from the trigger whitelist
+func (c *ShodanClient) RemoveTriggerIgnore(alertID, trigger, service string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/shodan/alert/%s/trigger/%s/ignore/%s?key=%s", c.BaseURL, alertID, trigger, service, c.ApiKey), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doJSON(req, nil)
+}
+
 // AddNotifier adds a notifier to an existing alert
 func (c *ShodanClient) AddNotifier(alertID, notifierID string) error {
 	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/shodan/alert/%s/notifier/%s?key=%s", c.BaseURL, alertID, notifierID, c.ApiKey), nil)
@@ -110,6 +199,16 @@ func (c *ShodanClient) AddNotifier(alertID, notifierID string) error {
 	return nil
 }
 
+// RemoveNotifier removes a notifier from an existing alert
+func (c *ShodanClient) RemoveNotifier(alertID, notifierID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/shodan/alert/%s/notifier/%s?key=%s", c.BaseURL, alertID, notifierID, c.ApiKey), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	return c.doJSON(req, nil)
+}
+
 // AddEmailNotifier adds an email notifier to an existing alert
 func (c *ShodanClient) AddEmailNotifier(alertID, email string) error {
 	// First, we need to create a custom notifier for the email
@@ -128,7 +227,8 @@ func (c *ShodanClient) AddSlackNotifier(alertID, notifierID string) error {
 	return c.AddNotifier(alertID, notifierID)
 }
 
-// GetAlert retrieves an existing alert by ID
+// GetAlert retrieves an existing alert by ID. It returns ErrAlertNotFound if
+// Shodan has no alert with that ID.
 func (c *ShodanClient) GetAlert(alertID string) (*AlertResponse, error) {
 	// Use the correct endpoint with /info as per Shodan API documentation
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s/shodan/alert/%s/info?key=%s", c.BaseURL, alertID, c.ApiKey), nil)
@@ -142,6 +242,10 @@ func (c *ShodanClient) GetAlert(alertID string) (*AlertResponse, error) {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("alert %s: %w", alertID, ErrAlertNotFound)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
@@ -160,6 +264,49 @@ func (c *ShodanClient) GetAlert(alertID string) (*AlertResponse, error) {
 	return &alertResp, nil
 }
 
+// ListAlerts retrieves all alerts configured for the account
+func (c *ShodanClient) ListAlerts() ([]AlertResponse, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/shodan/alert/info?key=%s", c.BaseURL, c.ApiKey), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var alerts []AlertResponse
+	if err := c.doJSON(req, &alerts); err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+// ListAlertsCached returns the alerts as they were first listed by this
+// provider process. It is only meant for advisory checks made while planning.
+func (c *ShodanClient) ListAlertsCached() ([]AlertResponse, error) {
+	var alerts []AlertResponse
+	if err := c.cachedJSON("/shodan/alert/info", nil, &alerts); err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+// FindAlertsByName returns every alert whose name matches the given predicate
+func (c *ShodanClient) FindAlertsByName(match func(name string) bool) ([]AlertResponse, error) {
+	alerts, err := c.ListAlerts()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []AlertResponse
+	for _, alert := range alerts {
+		if match(alert.Name) {
+			matches = append(matches, alert)
+		}
+	}
+
+	return matches, nil
+}
+
 // DeleteAlert deletes an existing alert by ID
 func (c *ShodanClient) DeleteAlert(alertID string) error {
 	// Use the working DELETE endpoint that matches the successful curl command
@@ -224,11 +371,125 @@ func (c *ShodanClient) UpdateAlert(alertID string, filters map[string]interface{
 	return nil
 }
 
+// doJSON sends the request and decodes a successful JSON response into out.
+// A nil out discards the response body.
+func (c *ShodanClient) doJSON(req *http.Request, out interface{}) error {
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return decodeJSON(body, out)
+}
+
+// cachedJSON performs a GET request against a reference endpoint once per
+// provider process and decodes the cached response into out. Responses are
+// cached per endpoint and query parameters; params may be nil.
+func (c *ShodanClient) cachedJSON(endpoint string, params url.Values, out interface{}) error {
+	cacheKey := endpoint
+	if len(params) > 0 {
+		cacheKey = fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	}
+
+	if body, ok := c.cache.Load(cacheKey); ok {
+		return decodeJSON(body.([]byte), out)
+	}
+
+	query := url.Values{}
+	for name, values := range params {
+		query[name] = values
+	}
+	query.Set("key", c.ApiKey)
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s?%s", c.BaseURL, endpoint, query.Encode()), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	c.cache.Store(cacheKey, body)
+	return decodeJSON(body, out)
+}
+
+// doRequest sends the request and returns the body of a successful response
+func (c *ShodanClient) doRequest(req *http.Request) ([]byte, error) {
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
+
+// getOptionalJSON performs a GET request through httpClient and decodes the
+// response into out. It reports false without an error when the API answers
+// 404, for lookups where a missing record is not a failure.
+func getOptionalJSON(httpClient *RateLimitedHTTPClient, endpoint string, out interface{}) (bool, error) {
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return true, decodeJSON(body, out)
+}
+
+// decodeJSON unmarshals a response body into out, ignoring it if out is nil
+func decodeJSON(body []byte, out interface{}) error {
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return nil
+}
+
 // Close cleans up the rate limiter resources
 func (c *ShodanClient) Close() {
 	if c.HTTPClient != nil {
 		c.HTTPClient.Close()
 	}
+	if c.InternetDBHTTPClient != nil {
+		c.InternetDBHTTPClient.Close()
+	}
+	if c.CVEDBHTTPClient != nil {
+		c.CVEDBHTTPClient.Close()
+	}
 }
 
 // AlertResponse represents the response from Shodan API for alert operations
@@ -241,40 +502,154 @@ type AlertResponse struct {
 	Expires     int                    `json:"expires"`
 	Expiration  interface{}            `json:"expiration"`
 	Filters     map[string]interface{} `json:"filters"`
+	Notifiers   []AlertNotifier        `json:"notifiers"`
 	Size        int                    `json:"size"`
 }
 
-// GetDomainInfo retrieves domain information including subdomains and DNS records
-func (c *ShodanClient) GetDomainInfo(domain string) (*DomainInfo, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/dns/domain/%s?key=%s", c.BaseURL, domain, c.ApiKey), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// AlertNotifier is a notifier attached to an alert
+type AlertNotifier struct {
+	ID       string `json:"id"`
+	Provider string `json:"provider"`
+}
+
+// AlertTrigger describes a trigger rule that can be enabled on an alert
+type AlertTrigger struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Rule        string `json:"rule"`
+	Default     bool   `json:"default"`
+}
+
+// IgnoredServices returns the services (ip:port) whitelisted for a trigger.
+// It reports false if the trigger is not enabled on the alert.
+func (a *AlertResponse) IgnoredServices(trigger string) ([]string, bool) {
+	config, ok := a.Triggers[trigger]
+	if !ok {
+		return nil, false
+	}
+
+	settings, _ := config.(map[string]interface{})
+	entries, _ := settings["ignore"].([]interface{})
+
+	services := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		switch v := entry.(type) {
+		case string:
+			services = append(services, v)
+		case map[string]interface{}:
+			// Some responses describe the service as an object instead of ip:port
+			if ip, ok := v["ip"].(string); ok {
+				if port, ok := v["port"].(float64); ok {
+					services = append(services, fmt.Sprintf("%s:%d", ip, int64(port)))
+				}
+			}
+		}
+	}
+
+	return services, true
+}
+
+// expirationLayouts are the timestamp formats Shodan uses for alert expiration
+var expirationLayouts = []string{
+	"2006-01-02T15:04:05.999999",
+	time.RFC3339Nano,
+}
+
+// ExpirationTime returns when the alert expires. It reports false if the
+// alert has no expiration or the timestamp cannot be parsed.
+func (a *AlertResponse) ExpirationTime() (time.Time, bool) {
+	expiration, ok := a.Expiration.(string)
+	if !ok || expiration == "" {
+		return time.Time{}, false
 	}
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+	for _, layout := range expirationLayouts {
+		if t, err := time.Parse(layout, expiration); err == nil {
+			return t, true
+		}
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	return time.Time{}, false
+}
+
+// Expired reports whether the alert's expiration time has passed
+func (a *AlertResponse) Expired(now time.Time) bool {
+	expiration, ok := a.ExpirationTime()
+	return ok && !now.Before(expiration)
+}
+
+// DomainQuery holds the optional parameters of a domain lookup
+type DomainQuery struct {
+	// History includes records Shodan has seen in the past
+	History bool
+
+	// Type only returns DNS records of the given type, such as "A"
+	Type string
+}
+
+// GetDomainInfo retrieves domain information including subdomains and DNS
+// records. Pages are fetched in turn until Shodan reports no more results or
+// maxPages have been fetched; More is set on the result if records remain.
+func (c *ShodanClient) GetDomainInfo(domain string, query DomainQuery, maxPages int) (*DomainInfo, error) {
+	var domainInfo DomainInfo
+	for page := 1; page <= maxPages; page++ {
+		info, err := c.getDomainPage(domain, query, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if page == 1 {
+			domainInfo.Domain = info.Domain
+		}
+		domainInfo.Tags = appendUnique(domainInfo.Tags, info.Tags...)
+		domainInfo.Subdomains = appendUnique(domainInfo.Subdomains, info.Subdomains...)
+		domainInfo.Data = append(domainInfo.Data, info.Data...)
+		domainInfo.More = info.More
+
+		if !info.More || len(info.Data) == 0 {
+			break
+		}
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	return &domainInfo, nil
+}
+
+// getDomainPage retrieves a single page of domain information
+func (c *ShodanClient) getDomainPage(domain string, query DomainQuery, page int) (*DomainInfo, error) {
+	params := url.Values{}
+	params.Set("key", c.ApiKey)
+	params.Set("page", strconv.Itoa(page))
+	if query.History {
+		params.Set("history", "true")
+	}
+	if query.Type != "" {
+		params.Set("type", query.Type)
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/dns/domain/%s?%s", c.BaseURL, domain, params.Encode()), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	var domainInfo DomainInfo
-	if err := json.Unmarshal(body, &domainInfo); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	if err := c.doJSON(req, &domainInfo); err != nil {
+		return nil, err
 	}
 
 	return &domainInfo, nil
 }
 
+// appendUnique appends the values not already present in list
+func appendUnique(list []string, values ...string) []string {
+	for _, value := range values {
+		if !slices.Contains(list, value) {
+			list = append(list, value)
+		}
+	}
+
+	return list
+}
+
 // ResolveDomain resolves a domain to its actual IP addresses using system DNS
 func (c *ShodanClient) ResolveDomain(domain string) ([]string, error) {
 	ips, err := net.LookupHost(domain)
@@ -284,8 +659,63 @@ func (c *ShodanClient) ResolveDomain(domain string) ([]string, error) {
 	return ips, nil
 }
 
+// dnsBatchSize is the number of hostnames or IPs sent in a single DNS request
+const dnsBatchSize = 100
+
+// DNSResolve looks up the IP address Shodan resolves each hostname to.
+// Hostnames that don't resolve are missing from the result. Large lists are
+// split into several requests, each going through the rate limiter.
+func (c *ShodanClient) DNSResolve(hostnames []string) (map[string]string, error) {
+	result := make(map[string]string, len(hostnames))
+	for batch := range slices.Chunk(hostnames, dnsBatchSize) {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/dns/resolve?hostnames=%s&key=%s", c.BaseURL, url.QueryEscape(strings.Join(batch, ",")), c.ApiKey), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var resolved map[string]*string
+		if err := c.doJSON(req, &resolved); err != nil {
+			return nil, err
+		}
+
+		for hostname, ip := range resolved {
+			if ip != nil && *ip != "" {
+				result[hostname] = *ip
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// DNSReverse looks up the hostnames Shodan has for each IP address. IPs
+// without hostnames are missing from the result. Large lists are split into
+// several requests like DNSResolve.
+func (c *ShodanClient) DNSReverse(ips []string) (map[string][]string, error) {
+	result := make(map[string][]string, len(ips))
+	for batch := range slices.Chunk(ips, dnsBatchSize) {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/dns/reverse?ips=%s&key=%s", c.BaseURL, url.QueryEscape(strings.Join(batch, ",")), c.ApiKey), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var reversed map[string][]string
+		if err := c.doJSON(req, &reversed); err != nil {
+			return nil, err
+		}
+
+		for ip, hostnames := range reversed {
+			if len(hostnames) > 0 {
+				result[ip] = hostnames
+			}
+		}
+	}
+
+	return result, nil
+}
+
 // CreateDomainAlert creates a new Shodan alert for monitoring a domain
-func (c *ShodanClient) CreateDomainAlert(name string, domain string, triggers []string) (*AlertResponse, error) {
+func (c *ShodanClient) CreateDomainAlert(name string, domain string, meta AlertMetadata, triggers []string, expires int64) (*AlertResponse, error) {
 	// Use proper DNS resolution instead of trusting Shodan's historical data
 	ips, err := c.ResolveDomain(domain)
 	if err != nil {
@@ -301,16 +731,13 @@ func (c *ShodanClient) CreateDomainAlert(name string, domain string, triggers []
 		"ip": ips,
 	}
 
-	// Create the alert with domain-specific naming
-	alertName := fmt.Sprintf("__domain: %s", domain)
-	if name != "" {
-		alertName = fmt.Sprintf("__domain: %s (%s)", domain, name)
-	}
-
 	payload := map[string]interface{}{
-		"name":    alertName,
+		"name":    AlertNameWithMetadata(DomainAlertName(domain, name), meta),
 		"filters": filters,
 	}
+	if expires > 0 {
+		payload["expires"] = expires
+	}
 
 	jsonData, err := json.Marshal(payload)
 	if err != nil {
@@ -347,6 +774,96 @@ func (c *ShodanClient) CreateDomainAlert(name string, domain string, triggers []
 	return &alertResp, nil
 }
 
+// DomainAlertName returns the alert name used for domain monitoring alerts.
+// The format is "__domain: <domain>" or "__domain: <domain> (<name>)" when a
+// custom name is given.
+func DomainAlertName(domain, name string) string {
+	if name == "" {
+		return fmt.Sprintf("%s%s", domainAlertPrefix, domain)
+	}
+	return fmt.Sprintf("%s%s (%s)", domainAlertPrefix, domain, name)
+}
+
+// ParseDomainAlertName is the inverse of DomainAlertName. It reports false if
+// the alert name does not follow the domain alert naming convention.
+func ParseDomainAlertName(alertName string) (domain string, name string, ok bool) {
+	alertName, _ = ParseAlertName(alertName)
+
+	rest, found := strings.CutPrefix(alertName, domainAlertPrefix)
+	if !found || rest == "" {
+		return "", "", false
+	}
+
+	// A custom name is appended in parentheses after the domain
+	if idx := strings.Index(rest, " ("); idx >= 0 && strings.HasSuffix(rest, ")") {
+		domain = rest[:idx]
+		name = rest[idx+2 : len(rest)-1]
+	} else {
+		domain = rest
+	}
+
+	if domain == "" || strings.ContainsAny(domain, " ()") {
+		return "", "", false
+	}
+
+	return domain, name, true
+}
+
+// alertMetadataMarker starts the metadata suffix of an alert name
+const alertMetadataMarker = " [tf:"
+
+// AlertMetadata holds alert settings that Shodan has no field for. They are
+// stored as a JSON suffix on the alert name, e.g. `web [tf:{"tags":["prod"]}]`.
+type AlertMetadata struct {
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// IsEmpty reports whether there is no metadata to store
+func (m AlertMetadata) IsEmpty() bool {
+	return m.Description == "" && len(m.Tags) == 0
+}
+
+// AlertNameWithMetadata appends the metadata to an alert name. Tags are sorted
+// so the same metadata always produces the same name. Empty metadata is only
+// appended when the name itself ends in something that reads as metadata.
+func AlertNameWithMetadata(name string, meta AlertMetadata) string {
+	if parsed, _ := ParseAlertName(name); meta.IsEmpty() && parsed == name {
+		return name
+	}
+
+	meta.Tags = slices.Clone(meta.Tags)
+	slices.Sort(meta.Tags)
+
+	encoded, err := json.Marshal(meta)
+	if err != nil {
+		return name
+	}
+	return name + alertMetadataMarker + string(encoded) + "]"
+}
+
+// ParseAlertName is the inverse of AlertNameWithMetadata. Names without a valid
+// metadata suffix are returned unchanged with empty metadata.
+func ParseAlertName(alertName string) (string, AlertMetadata) {
+	for offset := 0; ; {
+		idx := strings.Index(alertName[offset:], alertMetadataMarker)
+		if idx < 0 {
+			return alertName, AlertMetadata{}
+		}
+		idx += offset
+
+		suffix := alertName[idx+len(alertMetadataMarker):]
+		if encoded, found := strings.CutSuffix(suffix, "]"); found {
+			var meta AlertMetadata
+			if err := json.Unmarshal([]byte(encoded), &meta); err == nil {
+				return alertName[:idx], meta
+			}
+		}
+
+		offset = idx + 1
+	}
+}
+
 // DomainInfo represents the response from Shodan API for domain information
 type DomainInfo struct {
 	Domain     string       `json:"domain"`
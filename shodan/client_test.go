@@ -0,0 +1,188 @@
+package shodan
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAlertNameWithMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+		meta AlertMetadata
+		want string
+	}{
+		{
+			name: "web",
+			want: "web",
+		},
+		{
+			name: "web",
+			meta: AlertMetadata{Description: "Production web tier", Tags: []string{"web", "production"}},
+			want: `web [tf:{"description":"Production web tier","tags":["production","web"]}]`,
+		},
+		{
+			name: "web (eu)",
+			meta: AlertMetadata{Tags: []string{"prod"}},
+			want: `web (eu) [tf:{"tags":["prod"]}]`,
+		},
+		{
+			name: `web [tf:{"tags":["prod"]}]`,
+			want: `web [tf:{"tags":["prod"]}] [tf:{}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := AlertNameWithMetadata(tt.name, tt.meta); got != tt.want {
+				t.Errorf("AlertNameWithMetadata(%q, %+v) = %q, want %q", tt.name, tt.meta, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAlertName(t *testing.T) {
+	tests := []struct {
+		alertName string
+		wantName  string
+		wantMeta  AlertMetadata
+	}{
+		{
+			alertName: "web",
+			wantName:  "web",
+		},
+		{
+			alertName: `web [tf:{"description":"Production web tier","tags":["production","web"]}]`,
+			wantName:  "web",
+			wantMeta:  AlertMetadata{Description: "Production web tier", Tags: []string{"production", "web"}},
+		},
+		{
+			alertName: "web [tf: staging",
+			wantName:  "web [tf: staging",
+		},
+		{
+			alertName: `web [tf: staging [tf:{"tags":["prod"]}]`,
+			wantName:  "web [tf: staging",
+			wantMeta:  AlertMetadata{Tags: []string{"prod"}},
+		},
+		{
+			alertName: `web [tf:{"tags":["prod"]`,
+			wantName:  `web [tf:{"tags":["prod"]`,
+		},
+		{
+			alertName: `web [tf:{"tags":"prod"}]`,
+			wantName:  `web [tf:{"tags":"prod"}]`,
+		},
+		{
+			alertName: `web [tf:not json]`,
+			wantName:  `web [tf:not json]`,
+		},
+		{
+			alertName: `web [tf:{"tags":["prod"]}] [tf:{}]`,
+			wantName:  `web [tf:{"tags":["prod"]}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alertName, func(t *testing.T) {
+			name, meta := ParseAlertName(tt.alertName)
+			if name != tt.wantName {
+				t.Errorf("ParseAlertName(%q) name = %q, want %q", tt.alertName, name, tt.wantName)
+			}
+			if !reflect.DeepEqual(meta, tt.wantMeta) {
+				t.Errorf("ParseAlertName(%q) metadata = %+v, want %+v", tt.alertName, meta, tt.wantMeta)
+			}
+		})
+	}
+}
+
+func TestAlertNameRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		meta AlertMetadata
+	}{
+		{name: "web"},
+		{name: "web (eu)", meta: AlertMetadata{Description: "(primary)"}},
+		{name: "web [tf:", meta: AlertMetadata{Tags: []string{"a", "b"}}},
+		{name: "web [tf:]"},
+		{name: `web [tf:{}]`},
+		{name: `web [tf:{"description":"x"}]`, meta: AlertMetadata{Description: "y"}},
+		{name: "__domain: example.com (shop)", meta: AlertMetadata{Description: "Marketing site"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, meta := ParseAlertName(AlertNameWithMetadata(tt.name, tt.meta))
+			if name != tt.name {
+				t.Errorf("round trip of %q returned name %q", tt.name, name)
+			}
+			if !reflect.DeepEqual(meta, tt.meta) {
+				t.Errorf("round trip of %q returned metadata %+v, want %+v", tt.name, meta, tt.meta)
+			}
+		})
+	}
+}
+
+func TestParseDomainAlertName(t *testing.T) {
+	tests := []struct {
+		alertName  string
+		wantDomain string
+		wantName   string
+		wantOK     bool
+	}{
+		{alertName: "__domain: example.com", wantDomain: "example.com", wantOK: true},
+		{alertName: "__domain: example.com (shop)", wantDomain: "example.com", wantName: "shop", wantOK: true},
+		{alertName: "__domain: example.com (shop (eu))", wantDomain: "example.com", wantName: "shop (eu)", wantOK: true},
+		{alertName: `__domain: example.com [tf:{"description":"Marketing site"}]`, wantDomain: "example.com", wantOK: true},
+		{alertName: `__domain: example.com (shop) [tf:{"description":"x"}]`, wantDomain: "example.com", wantName: "shop", wantOK: true},
+		{alertName: "__domain: example.com (shop [tf:)", wantDomain: "example.com", wantName: "shop [tf:", wantOK: true},
+		{alertName: "__domain: example.com [tf:not json]"},
+		{alertName: "__domain: example.com (shop"},
+		{alertName: "__domain: "},
+		{alertName: "__domain:  (shop)"},
+		{alertName: "web"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alertName, func(t *testing.T) {
+			domain, name, ok := ParseDomainAlertName(tt.alertName)
+			if domain != tt.wantDomain || name != tt.wantName || ok != tt.wantOK {
+				t.Errorf("ParseDomainAlertName(%q) = (%q, %q, %t), want (%q, %q, %t)",
+					tt.alertName, domain, name, ok, tt.wantDomain, tt.wantName, tt.wantOK)
+			}
+		})
+	}
+
+	// DomainAlertName must produce names ParseDomainAlertName accepts
+	for _, name := range []string{"", "shop", "shop (eu)"} {
+		domain, got, ok := ParseDomainAlertName(DomainAlertName("example.com", name))
+		if !ok || domain != "example.com" || got != name {
+			t.Errorf("ParseDomainAlertName(DomainAlertName(%q, %q)) = (%q, %q, %t)", "example.com", name, domain, got, ok)
+		}
+	}
+}
+
+func TestGetAlertNotFound(t *testing.T) {
+	tests := []struct {
+		status       int
+		wantNotFound bool
+	}{
+		{status: http.StatusNotFound, wantNotFound: true},
+		{status: http.StatusUnauthorized},
+		{status: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		fake := &fakeShodan{failing: map[string]int{"GET /shodan/alert/A1/info": tt.status}}
+		client := newTestClient(t, fake)
+
+		_, err := client.GetAlert("A1")
+		if err == nil {
+			t.Fatalf("status %d: got no error", tt.status)
+		}
+		if got := errors.Is(err, ErrAlertNotFound); got != tt.wantNotFound {
+			t.Errorf("status %d: errors.Is(%v, ErrAlertNotFound) = %t, want %t", tt.status, err, got, tt.wantNotFound)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// createTransaction tracks the alert created by a resource Create so it isn't
+// orphaned on Shodan when the rest of the create fails.
+type createTransaction struct {
+	client *ShodanClient
+
+	// createdID is the alert created by this transaction
+	createdID string
+
+	// adoptedID is an existing alert taken over by this transaction. It is
+	// never deleted on rollback.
+	adoptedID string
+}
+
+// created records an alert created by this transaction
+func (t *createTransaction) created(alertID string) {
+	t.createdID = alertID
+}
+
+// adopted records an existing alert taken over by this transaction
+func (t *createTransaction) adopted(alertID string) {
+	t.adoptedID = alertID
+}
+
+// commit saves the state if the create succeeded. Otherwise the created alert
+// is deleted; if that fails too, the partial state is kept so Terraform marks
+// the resource as tainted and replaces it on the next apply. An adopted alert
+// is never tainted, since replacing it would delete an alert that existed
+// before this apply: its errors become warnings and its state is saved.
+func (t *createTransaction) commit(ctx context.Context, state interface{}, resp *resource.CreateResponse) {
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	switch {
+	case t.adoptedID != "":
+		failures := resp.Diagnostics
+		resp.Diagnostics = nil
+		for _, d := range failures {
+			if d.Severity() == diag.SeverityError {
+				resp.Diagnostics.AddWarning(d.Summary(), d.Detail())
+				continue
+			}
+			resp.Diagnostics.Append(d)
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		resp.Diagnostics.AddWarning(
+			"Adopted alert kept in state",
+			fmt.Sprintf("Existing alert %s was adopted but could not be fully configured. It was not deleted because it existed before this apply; it has been saved to state with what is actually configured, so the next apply will retry the rest.", t.adoptedID),
+		)
+
+	case t.createdID != "":
+		err := t.client.DeleteAlert(t.createdID)
+		if err == nil {
+			resp.State.RemoveResource(ctx)
+			resp.Diagnostics.AddError(
+				"Alert creation rolled back",
+				fmt.Sprintf("Alert %s could not be fully created and has been deleted from Shodan. Nothing was saved to state, so the next apply will create it again.", t.createdID),
+			)
+			return
+		}
+
+		// Keep at least the ID so Terraform can manage the alert
+		if diags := resp.State.Set(ctx, state); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), t.createdID)...)
+		}
+		resp.Diagnostics.AddError(
+			"Alert creation could not be rolled back",
+			fmt.Sprintf("Alert %s could not be fully created and deleting it failed: %s. It has been saved to state with what was configured and marked as tainted, so Terraform will delete and replace it on the next apply.", t.createdID, err.Error()),
+		)
+	}
+}
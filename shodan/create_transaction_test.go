@@ -0,0 +1,138 @@
+package shodan
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// transactionTestModel is a minimal resource model for createTransaction tests
+type transactionTestModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// newCreateResponse returns a create response with empty state
+func newCreateResponse(ctx context.Context) *resource.CreateResponse {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+		},
+	}
+
+	return &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+	}
+}
+
+func TestCreateTransactionCommit(t *testing.T) {
+	tests := []struct {
+		name         string
+		created      string
+		adopted      string
+		failed       bool
+		failing      map[string]int
+		wantRequests []string
+		wantState    bool
+		wantErrors   []string
+		wantWarnings []string
+	}{
+		{
+			name:      "success",
+			created:   "A1",
+			wantState: true,
+		},
+		{
+			name:         "rolled back",
+			created:      "A1",
+			failed:       true,
+			wantRequests: []string{"DELETE /shodan/alert/A1"},
+			wantErrors:   []string{"Configuration failed", "Alert creation rolled back"},
+		},
+		{
+			name:         "rollback failed",
+			created:      "A1",
+			failed:       true,
+			failing:      map[string]int{"DELETE /shodan/alert/A1": http.StatusInternalServerError},
+			wantRequests: []string{"DELETE /shodan/alert/A1"},
+			wantState:    true,
+			wantErrors:   []string{"Configuration failed", "Alert creation could not be rolled back"},
+		},
+		{
+			name:         "adopted alerts are never deleted or tainted",
+			adopted:      "A1",
+			failed:       true,
+			wantState:    true,
+			wantWarnings: []string{"Configuration failed", "Adopted alert kept in state"},
+		},
+		{
+			name:       "nothing created",
+			failed:     true,
+			wantErrors: []string{"Configuration failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			fake := &fakeShodan{failing: tt.failing}
+			tx := &createTransaction{client: newTestClient(t, fake)}
+			if tt.created != "" {
+				tx.created(tt.created)
+			}
+			if tt.adopted != "" {
+				tx.adopted(tt.adopted)
+			}
+
+			resp := newCreateResponse(ctx)
+			if tt.failed {
+				resp.Diagnostics.AddError("Configuration failed", "A trigger could not be added.")
+			}
+
+			model := transactionTestModel{ID: types.StringValue("A1"), Name: types.StringValue("web")}
+			tx.commit(ctx, model, resp)
+
+			if !slices.Equal(fake.requests, tt.wantRequests) {
+				t.Errorf("got requests %q, want %q", fake.requests, tt.wantRequests)
+			}
+
+			var errors []string
+			for _, d := range resp.Diagnostics.Errors() {
+				errors = append(errors, d.Summary())
+			}
+			if !slices.Equal(errors, tt.wantErrors) {
+				t.Errorf("got errors %q, want %q", errors, tt.wantErrors)
+			}
+
+			var warnings []string
+			for _, d := range resp.Diagnostics.Warnings() {
+				warnings = append(warnings, d.Summary())
+			}
+			if !slices.Equal(warnings, tt.wantWarnings) {
+				t.Errorf("got warnings %q, want %q", warnings, tt.wantWarnings)
+			}
+
+			if got := !resp.State.Raw.IsNull(); got != tt.wantState {
+				t.Fatalf("state saved = %t, want %t", got, tt.wantState)
+			}
+			if tt.wantState {
+				var saved transactionTestModel
+				resp.Diagnostics.Append(resp.State.Get(ctx, &saved)...)
+				if saved != model {
+					t.Errorf("got state %+v, want %+v", saved, model)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package shodan
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// CVE represents a vulnerability as returned by the CVEDB API
+type CVE struct {
+	ID                 string   `json:"cve_id"`
+	Summary            string   `json:"summary"`
+	CVSS               *float64 `json:"cvss"`
+	CVSSVersion        *float64 `json:"cvss_version"`
+	CVSSv2             *float64 `json:"cvss_v2"`
+	CVSSv3             *float64 `json:"cvss_v3"`
+	EPSS               *float64 `json:"epss"`
+	RankingEPSS        *float64 `json:"ranking_epss"`
+	KEV                bool     `json:"kev"`
+	ProposeAction      *string  `json:"propose_action"`
+	RansomwareCampaign *string  `json:"ransomware_campaign"`
+	References         []string `json:"references"`
+	PublishedTime      string   `json:"published_time"`
+	CPEs               []string `json:"cpes"`
+}
+
+// CVEQuery holds the parameters of a CVEDB search. Exactly one of Product and
+// CPE23 must be set.
+type CVEQuery struct {
+	Product    string
+	CPE23      string
+	IsKEV      bool
+	SortByEPSS bool
+
+	// Limit caps the number of results; zero uses the CVEDB default
+	Limit int
+}
+
+// GetCVE retrieves a vulnerability by its CVE ID. It returns nil without an
+// error when CVEDB does not know the CVE.
+func (c *ShodanClient) GetCVE(id string) (*CVE, error) {
+	var cve CVE
+	found, err := getOptionalJSON(c.CVEDBHTTPClient, fmt.Sprintf("%s/cve/%s", c.CVEDBURL, url.PathEscape(id)), &cve)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return &cve, nil
+}
+
+// SearchCVEs retrieves the vulnerabilities affecting a product or CPE
+func (c *ShodanClient) SearchCVEs(query CVEQuery) ([]CVE, error) {
+	params := url.Values{}
+	if query.Product != "" {
+		params.Set("product", query.Product)
+	}
+	if query.CPE23 != "" {
+		params.Set("cpe23", query.CPE23)
+	}
+	if query.IsKEV {
+		params.Set("is_kev", "true")
+	}
+	if query.SortByEPSS {
+		params.Set("sort_by_epss", "true")
+	}
+	if query.Limit > 0 {
+		params.Set("limit", strconv.Itoa(query.Limit))
+	}
+
+	var result struct {
+		CVEs []CVE `json:"cves"`
+	}
+	// A 404 means nothing matched, which is an empty result rather than an error
+	if _, err := getOptionalJSON(c.CVEDBHTTPClient, fmt.Sprintf("%s/cves?%s", c.CVEDBURL, params.Encode()), &result); err != nil {
+		return nil, err
+	}
+
+	return result.CVEs, nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -50,6 +52,9 @@ func (d *ShodanAlertDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 			"id": schema.StringAttribute{
 				Description: "The unique identifier for the Shodan alert.",
 				Required:    true,
+				Validators: []validator.String{
+					validators.NotBlank(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the Shodan alert.",
@@ -92,12 +97,17 @@ func (d *ShodanAlertDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *ShodanAlertDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *ShodanAlertDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*ShodanClient)
+	client := req.ProviderData.(*ShodanClient)
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -121,7 +131,16 @@ func (d *ShodanAlertDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Set computed values
-	config.Name = types.StringValue(alert.Name)
+	name, meta := ParseAlertName(alert.Name)
+	config.Name = types.StringValue(name)
+	if meta.Description != "" {
+		config.Description = types.StringValue(meta.Description)
+	}
+	if len(meta.Tags) > 0 {
+		tags, diags := types.ListValueFrom(ctx, types.StringType, meta.Tags)
+		resp.Diagnostics.Append(diags...)
+		config.Tags = tags
+	}
 	config.CreatedAt = types.StringValue(alert.Created)
 	config.Enabled = types.BoolValue(alert.HasTriggers)
 
@@ -0,0 +1,134 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanAlertTriggersDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanAlertTriggersDataSource{}
+)
+
+// ShodanAlertTriggersDataSource is the data source implementation.
+type ShodanAlertTriggersDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanAlertTriggersDataSourceModel describes the data source data model.
+type ShodanAlertTriggersDataSourceModel struct {
+	Names    []types.String               `tfsdk:"names"`
+	Triggers []AlertTriggerCatalogueModel `tfsdk:"triggers"`
+}
+
+// AlertTriggerCatalogueModel represents a trigger in the trigger catalogue
+type AlertTriggerCatalogueModel struct {
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Rule             types.String `tfsdk:"rule"`
+	EnabledByDefault types.Bool   `tfsdk:"enabled_by_default"`
+}
+
+func NewShodanAlertTriggersDataSource() datasource.DataSource {
+	return &ShodanAlertTriggersDataSource{}
+}
+
+func (d *ShodanAlertTriggersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_triggers"
+}
+
+func (d *ShodanAlertTriggersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the trigger rules that can be enabled on Shodan alerts.",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Description: "Names of all available triggers.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"triggers": schema.ListNestedAttribute{
+				Description: "All available triggers.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the trigger.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "What the trigger detects.",
+							Computed:    true,
+						},
+						"rule": schema.StringAttribute{
+							Description: "The rule Shodan evaluates for the trigger.",
+							Computed:    true,
+						},
+						"enabled_by_default": schema.BoolAttribute{
+							Description: "Whether Shodan enables the trigger by default.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ShodanAlertTriggersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShodanAlertTriggersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanAlertTriggersDataSourceModel
+
+	// Get the trigger catalogue from Shodan
+	triggers, err := d.client.ListTriggers()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading alert triggers",
+			fmt.Sprintf("Could not read the alert trigger catalogue: %s", err.Error()),
+		)
+		return
+	}
+
+	// Convert the response to the data source model
+	data.Names = make([]types.String, len(triggers))
+	data.Triggers = make([]AlertTriggerCatalogueModel, len(triggers))
+	for i, trigger := range triggers {
+		data.Names[i] = types.StringValue(trigger.Name)
+		data.Triggers[i] = AlertTriggerCatalogueModel{
+			Name:             types.StringValue(trigger.Name),
+			Description:      types.StringValue(trigger.Description),
+			Rule:             types.StringValue(trigger.Rule),
+			EnabledByDefault: types.BoolValue(trigger.Default),
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
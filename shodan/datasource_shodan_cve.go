@@ -0,0 +1,205 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanCVEDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanCVEDataSource{}
+)
+
+// ShodanCVEDataSource is the data source implementation.
+type ShodanCVEDataSource struct {
+	client *ShodanClient
+}
+
+// CVEModel describes a vulnerability. It is the model of the shodan_cve data
+// source and of each CVE returned by shodan_cves.
+type CVEModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Summary            types.String   `tfsdk:"summary"`
+	CVSS               types.Float64  `tfsdk:"cvss"`
+	CVSSVersion        types.Float64  `tfsdk:"cvss_version"`
+	CVSSv2             types.Float64  `tfsdk:"cvss_v2"`
+	CVSSv3             types.Float64  `tfsdk:"cvss_v3"`
+	EPSS               types.Float64  `tfsdk:"epss"`
+	RankingEPSS        types.Float64  `tfsdk:"ranking_epss"`
+	KEV                types.Bool     `tfsdk:"kev"`
+	ProposeAction      types.String   `tfsdk:"propose_action"`
+	RansomwareCampaign types.String   `tfsdk:"ransomware_campaign"`
+	References         []types.String `tfsdk:"references"`
+	PublishedTime      types.String   `tfsdk:"published_time"`
+	CPEs               []types.String `tfsdk:"cpes"`
+}
+
+// newCVEModel converts a CVEDB vulnerability into its Terraform model
+func newCVEModel(cve CVE) CVEModel {
+	return CVEModel{
+		ID:                 types.StringValue(cve.ID),
+		Summary:            types.StringValue(cve.Summary),
+		CVSS:               types.Float64PointerValue(cve.CVSS),
+		CVSSVersion:        types.Float64PointerValue(cve.CVSSVersion),
+		CVSSv2:             types.Float64PointerValue(cve.CVSSv2),
+		CVSSv3:             types.Float64PointerValue(cve.CVSSv3),
+		EPSS:               types.Float64PointerValue(cve.EPSS),
+		RankingEPSS:        types.Float64PointerValue(cve.RankingEPSS),
+		KEV:                types.BoolValue(cve.KEV),
+		ProposeAction:      types.StringPointerValue(cve.ProposeAction),
+		RansomwareCampaign: types.StringPointerValue(cve.RansomwareCampaign),
+		References:         stringValues(cve.References),
+		PublishedTime:      types.StringValue(cve.PublishedTime),
+		CPEs:               stringValues(cve.CPEs),
+	}
+}
+
+// cveAttributes returns the computed attributes describing a vulnerability,
+// shared by shodan_cve and the CVEs listed by shodan_cves. The id attribute
+// is left to the caller.
+func cveAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"summary": schema.StringAttribute{
+			Description: "Description of the vulnerability.",
+			Computed:    true,
+		},
+		"cvss": schema.Float64Attribute{
+			Description: "The CVSS base score of the most recent CVSS version available.",
+			Computed:    true,
+		},
+		"cvss_version": schema.Float64Attribute{
+			Description: "The CVSS version cvss was scored with, such as 3.1.",
+			Computed:    true,
+		},
+		"cvss_v2": schema.Float64Attribute{
+			Description: "The CVSS v2 base score, if any.",
+			Computed:    true,
+		},
+		"cvss_v3": schema.Float64Attribute{
+			Description: "The CVSS v3 base score, if any.",
+			Computed:    true,
+		},
+		"epss": schema.Float64Attribute{
+			Description: "The EPSS probability, between 0 and 1, that the vulnerability is exploited in the next 30 days.",
+			Computed:    true,
+		},
+		"ranking_epss": schema.Float64Attribute{
+			Description: "The EPSS percentile of the vulnerability, between 0 and 1.",
+			Computed:    true,
+		},
+		"kev": schema.BoolAttribute{
+			Description: "Whether the vulnerability is in CISA's Known Exploited Vulnerabilities catalog.",
+			Computed:    true,
+		},
+		"propose_action": schema.StringAttribute{
+			Description: "The remediation CISA requires for KEV-listed vulnerabilities.",
+			Computed:    true,
+		},
+		"ransomware_campaign": schema.StringAttribute{
+			Description: "Whether the vulnerability is known to be used in ransomware campaigns, for KEV-listed vulnerabilities.",
+			Computed:    true,
+		},
+		"references": schema.ListAttribute{
+			Description: "URLs of advisories and other references.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"published_time": schema.StringAttribute{
+			Description: "When the vulnerability was published.",
+			Computed:    true,
+		},
+		"cpes": schema.ListAttribute{
+			Description: "CPEs of the products affected by the vulnerability.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	}
+}
+
+func NewShodanCVEDataSource() datasource.DataSource {
+	return &ShodanCVEDataSource{}
+}
+
+func (d *ShodanCVEDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cve"
+}
+
+func (d *ShodanCVEDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := cveAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The CVE ID to look up (e.g., 'CVE-2021-44228').",
+		Required:    true,
+		Validators: []validator.String{
+			validators.CVEID(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Look up a vulnerability in the free CVEDB API, including its CVSS and EPSS scores and KEV status. No API key or query credits are needed.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *ShodanCVEDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	// CVEDB needs no API key, so missingAPIKey is not checked here
+	d.client = client
+}
+
+func (d *ShodanCVEDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CVEModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the CVE through CVEDB
+	cve, err := d.client.GetCVE(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading CVE",
+			fmt.Sprintf("Could not read %s from CVEDB: %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if cve == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"CVE not found",
+			fmt.Sprintf("CVEDB has no record of %s.", data.ID.ValueString()),
+		)
+		return
+	}
+
+	data = newCVEModel(*cve)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,160 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanCVEsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanCVEsDataSource{}
+)
+
+// ShodanCVEsDataSource is the data source implementation.
+type ShodanCVEsDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanCVEsDataSourceModel describes the data source data model.
+type ShodanCVEsDataSourceModel struct {
+	Product    types.String   `tfsdk:"product"`
+	CPE23      types.String   `tfsdk:"cpe23"`
+	IsKEV      types.Bool     `tfsdk:"is_kev"`
+	SortByEPSS types.Bool     `tfsdk:"sort_by_epss"`
+	Limit      types.Int64    `tfsdk:"limit"`
+	IDs        []types.String `tfsdk:"ids"`
+	CVEs       []CVEModel     `tfsdk:"cves"`
+}
+
+func NewShodanCVEsDataSource() datasource.DataSource {
+	return &ShodanCVEsDataSource{}
+}
+
+func (d *ShodanCVEsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cves"
+}
+
+func (d *ShodanCVEsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	cveAttrs := cveAttributes()
+	cveAttrs["id"] = schema.StringAttribute{
+		Description: "The CVE ID.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Search the free CVEDB API for the vulnerabilities affecting a product or CPE. No API key or query credits are needed.",
+		Attributes: map[string]schema.Attribute{
+			"product": schema.StringAttribute{
+				Description: "The product to search for (e.g., 'openssh'). Exactly one of product and cpe23 must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotBlank(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("cpe23")),
+				},
+			},
+			"cpe23": schema.StringAttribute{
+				Description: "The CPE 2.3 string of the product to search for (e.g., 'cpe:2.3:a:openbsd:openssh:8.9').",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotBlank(),
+				},
+			},
+			"is_kev": schema.BoolAttribute{
+				Description: "Only return vulnerabilities in CISA's Known Exploited Vulnerabilities catalog. Defaults to false.",
+				Optional:    true,
+			},
+			"sort_by_epss": schema.BoolAttribute{
+				Description: "Sort the vulnerabilities by EPSS score, most likely to be exploited first. Defaults to false.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("The most vulnerabilities to return, between 1 and %d. Defaults to %d.", validators.MaxCVEResults, validators.MaxCVEResults),
+				Optional:    true,
+				Validators: []validator.Int64{
+					validators.CVELimit(),
+				},
+			},
+			"ids": schema.ListAttribute{
+				Description: "The CVE IDs of the vulnerabilities found, in result order.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"cves": schema.ListNestedAttribute{
+				Description: "The vulnerabilities found.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: cveAttrs,
+				},
+			},
+		},
+	}
+}
+
+func (d *ShodanCVEsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	// CVEDB needs no API key, so missingAPIKey is not checked here
+	d.client = client
+}
+
+func (d *ShodanCVEsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanCVEsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := CVEQuery{
+		Product:    data.Product.ValueString(),
+		CPE23:      data.CPE23.ValueString(),
+		IsKEV:      data.IsKEV.ValueBool(),
+		SortByEPSS: data.SortByEPSS.ValueBool(),
+		Limit:      int(data.Limit.ValueInt64()),
+	}
+
+	// Search CVEDB
+	cves, err := d.client.SearchCVEs(query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error searching CVEs",
+			fmt.Sprintf("Could not search CVEDB: %s", err.Error()),
+		)
+		return
+	}
+
+	data.IDs = make([]types.String, len(cves))
+	data.CVEs = make([]CVEModel, len(cves))
+	for i, cve := range cves {
+		data.IDs[i] = types.StringValue(cve.ID)
+		data.CVEs[i] = newCVEModel(cve)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
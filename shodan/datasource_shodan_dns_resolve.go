@@ -0,0 +1,133 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanDNSResolveDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanDNSResolveDataSource{}
+)
+
+// ShodanDNSResolveDataSource is the data source implementation.
+type ShodanDNSResolveDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanDNSResolveDataSourceModel describes the data source data model.
+type ShodanDNSResolveDataSourceModel struct {
+	Hostnames  []types.String `tfsdk:"hostnames"`
+	IPs        types.Map      `tfsdk:"ips"`
+	Unresolved []types.String `tfsdk:"unresolved"`
+}
+
+func NewShodanDNSResolveDataSource() datasource.DataSource {
+	return &ShodanDNSResolveDataSource{}
+}
+
+func (d *ShodanDNSResolveDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_resolve"
+}
+
+func (d *ShodanDNSResolveDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the IP addresses Shodan resolves hostnames to.",
+		Attributes: map[string]schema.Attribute{
+			"hostnames": schema.SetAttribute{
+				Description: "Hostnames to resolve (e.g., 'www.example.com').",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.Domain()),
+				},
+			},
+			"ips": schema.MapAttribute{
+				Description: "The IP address of each hostname that resolved, keyed by hostname.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"unresolved": schema.SetAttribute{
+				Description: "Hostnames that Shodan could not resolve.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ShodanDNSResolveDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShodanDNSResolveDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanDNSResolveDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostnames := valueStrings(data.Hostnames)
+	slices.Sort(hostnames)
+
+	// Resolve the hostnames through Shodan
+	resolved, err := d.client.DNSResolve(hostnames)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error resolving hostnames",
+			fmt.Sprintf("Could not resolve hostnames through Shodan: %s", err.Error()),
+		)
+		return
+	}
+
+	// Split the configured hostnames into resolved and unresolved ones
+	ips := make(map[string]string, len(hostnames))
+	data.Unresolved = []types.String{}
+	for _, hostname := range hostnames {
+		if ip, ok := resolved[hostname]; ok {
+			ips[hostname] = ip
+			continue
+		}
+		data.Unresolved = append(data.Unresolved, types.StringValue(hostname))
+	}
+
+	ipsValue, diags := types.MapValueFrom(ctx, types.StringType, ips)
+	resp.Diagnostics.Append(diags...)
+	data.IPs = ipsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
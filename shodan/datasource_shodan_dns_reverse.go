@@ -0,0 +1,133 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanDNSReverseDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanDNSReverseDataSource{}
+)
+
+// ShodanDNSReverseDataSource is the data source implementation.
+type ShodanDNSReverseDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanDNSReverseDataSourceModel describes the data source data model.
+type ShodanDNSReverseDataSourceModel struct {
+	IPs        []types.String `tfsdk:"ips"`
+	Hostnames  types.Map      `tfsdk:"hostnames"`
+	Unresolved []types.String `tfsdk:"unresolved"`
+}
+
+func NewShodanDNSReverseDataSource() datasource.DataSource {
+	return &ShodanDNSReverseDataSource{}
+}
+
+func (d *ShodanDNSReverseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_reverse"
+}
+
+func (d *ShodanDNSReverseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the hostnames Shodan has for IP addresses.",
+		Attributes: map[string]schema.Attribute{
+			"ips": schema.SetAttribute{
+				Description: "IP addresses to look up (e.g., '198.51.100.1').",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.IPAddress()),
+				},
+			},
+			"hostnames": schema.MapAttribute{
+				Description: "The hostnames of each IP address that has any, keyed by IP address.",
+				ElementType: types.ListType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			"unresolved": schema.SetAttribute{
+				Description: "IP addresses that Shodan has no hostnames for.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ShodanDNSReverseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShodanDNSReverseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanDNSReverseDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ips := valueStrings(data.IPs)
+	slices.Sort(ips)
+
+	// Look up the IPs through Shodan
+	reversed, err := d.client.DNSReverse(ips)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error looking up IP addresses",
+			fmt.Sprintf("Could not look up hostnames through Shodan: %s", err.Error()),
+		)
+		return
+	}
+
+	// Split the configured IPs into those with and without hostnames
+	hostnames := make(map[string][]string, len(ips))
+	data.Unresolved = []types.String{}
+	for _, ip := range ips {
+		if names, ok := reversed[ip]; ok {
+			hostnames[ip] = names
+			continue
+		}
+		data.Unresolved = append(data.Unresolved, types.StringValue(ip))
+	}
+
+	hostnamesValue, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, hostnames)
+	resp.Diagnostics.Append(diags...)
+	data.Hostnames = hostnamesValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -3,12 +3,28 @@ package shodan
 import (
 	"context"
 	"fmt"
+	"regexp"
 
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// defaultDomainPages is the number of pages fetched when max_pages is unset.
+// Every page costs a query credit, so only the first is fetched unless more are
+// asked for.
+const defaultDomainPages = 1
+
+// domainRecordAttrTypes describes the records grouped under each subdomain
+var domainRecordAttrTypes = map[string]attr.Type{
+	"type":      types.StringType,
+	"value":     types.StringType,
+	"last_seen": types.StringType,
+}
+
 // Ensure the implementation satisfies the expected interfaces
 var (
 	_ datasource.DataSource = &ShodanDomainDataSource{}
@@ -21,11 +37,16 @@ type ShodanDomainDataSource struct {
 
 // ShodanDomainDataSourceModel describes the data source data model.
 type ShodanDomainDataSourceModel struct {
-	Domain     types.String      `tfsdk:"domain"`
-	Tags       []types.String    `tfsdk:"tags"`
-	Subdomains []types.String    `tfsdk:"subdomains"`
-	Data       []DomainDataModel `tfsdk:"data"`
-	More       types.Bool        `tfsdk:"more"`
+	Domain         types.String      `tfsdk:"domain"`
+	History        types.Bool        `tfsdk:"history"`
+	Type           types.String      `tfsdk:"type"`
+	SubdomainRegex types.String      `tfsdk:"subdomain_regex"`
+	MaxPages       types.Int64       `tfsdk:"max_pages"`
+	Tags           []types.String    `tfsdk:"tags"`
+	Subdomains     []types.String    `tfsdk:"subdomains"`
+	Data           []DomainDataModel `tfsdk:"data"`
+	Records        types.Map         `tfsdk:"records"`
+	More           types.Bool        `tfsdk:"more"`
 }
 
 // DomainDataModel represents individual DNS records for a domain
@@ -36,6 +57,13 @@ type DomainDataModel struct {
 	LastSeen  types.String `tfsdk:"last_seen"`
 }
 
+// DomainRecordModel represents a DNS record grouped under its subdomain
+type DomainRecordModel struct {
+	Type     types.String `tfsdk:"type"`
+	Value    types.String `tfsdk:"value"`
+	LastSeen types.String `tfsdk:"last_seen"`
+}
+
 func NewShodanDomainDataSource() datasource.DataSource {
 	return &ShodanDomainDataSource{}
 }
@@ -51,6 +79,34 @@ func (d *ShodanDomainDataSource) Schema(ctx context.Context, req datasource.Sche
 			"domain": schema.StringAttribute{
 				Description: "The domain name to lookup (e.g., 'example.com').",
 				Required:    true,
+				Validators: []validator.String{
+					validators.Domain(),
+				},
+			},
+			"history": schema.BoolAttribute{
+				Description: "Whether to include DNS records Shodan has seen in the past. Defaults to false.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return DNS records of this type (A, AAAA, CNAME, MX, NS, SOA or TXT).",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.DNSRecordType(),
+				},
+			},
+			"subdomain_regex": schema.StringAttribute{
+				Description: "Only return subdomains matching this regular expression. The root domain has an empty subdomain.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.Regex(),
+				},
+			},
+			"max_pages": schema.Int64Attribute{
+				Description: fmt.Sprintf("The most pages of results to fetch, each costing a query credit. Defaults to %d, so results are only paged through when this is set.", defaultDomainPages),
+				Optional:    true,
+				Validators: []validator.Int64{
+					validators.Pages(),
+				},
 			},
 			"tags": schema.ListAttribute{
 				Description: "Tags associated with the domain.",
@@ -86,8 +142,13 @@ func (d *ShodanDomainDataSource) Schema(ctx context.Context, req datasource.Sche
 					},
 				},
 			},
+			"records": schema.MapAttribute{
+				Description: "DNS records grouped by subdomain, keyed by subdomain. The root domain is keyed by an empty string.",
+				ElementType: types.ListType{ElemType: types.ObjectType{AttrTypes: domainRecordAttrTypes}},
+				Computed:    true,
+			},
 			"more": schema.BoolAttribute{
-				Description: "Whether there are more results available.",
+				Description: "Whether more results were available than max_pages allowed fetching.",
 				Computed:    true,
 			},
 		},
@@ -111,6 +172,10 @@ func (d *ShodanDomainDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
 	d.client = client
 }
 
@@ -124,8 +189,31 @@ func (d *ShodanDomainDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	var subdomainPattern *regexp.Regexp
+	if !data.SubdomainRegex.IsNull() {
+		pattern, err := regexp.Compile(data.SubdomainRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid subdomain_regex",
+				fmt.Sprintf("Could not compile %q: %s", data.SubdomainRegex.ValueString(), err.Error()),
+			)
+			return
+		}
+		subdomainPattern = pattern
+	}
+
+	maxPages := defaultDomainPages
+	if !data.MaxPages.IsNull() {
+		maxPages = int(data.MaxPages.ValueInt64())
+	}
+
+	query := DomainQuery{
+		History: data.History.ValueBool(),
+		Type:    data.Type.ValueString(),
+	}
+
 	// Get domain information from Shodan
-	domainInfo, err := d.client.GetDomainInfo(data.Domain.ValueString())
+	domainInfo, err := d.client.GetDomainInfo(data.Domain.ValueString(), query, maxPages)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading domain information",
@@ -134,27 +222,47 @@ func (d *ShodanDomainDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	matches := func(subdomain string) bool {
+		return subdomainPattern == nil || subdomainPattern.MatchString(subdomain)
+	}
+
 	// Convert the response to the data source model
 	data.Tags = make([]types.String, len(domainInfo.Tags))
 	for i, tag := range domainInfo.Tags {
 		data.Tags[i] = types.StringValue(tag)
 	}
 
-	data.Subdomains = make([]types.String, len(domainInfo.Subdomains))
-	for i, subdomain := range domainInfo.Subdomains {
-		data.Subdomains[i] = types.StringValue(subdomain)
+	data.Subdomains = []types.String{}
+	for _, subdomain := range domainInfo.Subdomains {
+		if matches(subdomain) {
+			data.Subdomains = append(data.Subdomains, types.StringValue(subdomain))
+		}
 	}
 
-	data.Data = make([]DomainDataModel, len(domainInfo.Data))
-	for i, record := range domainInfo.Data {
-		data.Data[i] = DomainDataModel{
+	data.Data = []DomainDataModel{}
+	records := make(map[string][]DomainRecordModel)
+	for _, record := range domainInfo.Data {
+		if !matches(record.Subdomain) {
+			continue
+		}
+
+		data.Data = append(data.Data, DomainDataModel{
 			Subdomain: types.StringValue(record.Subdomain),
 			Type:      types.StringValue(record.Type),
 			Value:     types.StringValue(record.Value),
 			LastSeen:  types.StringValue(record.LastSeen),
-		}
+		})
+		records[record.Subdomain] = append(records[record.Subdomain], DomainRecordModel{
+			Type:     types.StringValue(record.Type),
+			Value:    types.StringValue(record.Value),
+			LastSeen: types.StringValue(record.LastSeen),
+		})
 	}
 
+	recordsValue, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.ObjectType{AttrTypes: domainRecordAttrTypes}}, records)
+	resp.Diagnostics.Append(diags...)
+	data.Records = recordsValue
+
 	data.More = types.BoolValue(domainInfo.More)
 
 	// Save data into Terraform state
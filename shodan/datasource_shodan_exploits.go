@@ -0,0 +1,263 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultExploitPages is the number of pages fetched when max_pages is unset
+const defaultExploitPages = 1
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanExploitsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanExploitsDataSource{}
+)
+
+// ShodanExploitsDataSource is the data source implementation.
+type ShodanExploitsDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanExploitsDataSourceModel describes the data source data model.
+type ShodanExploitsDataSourceModel struct {
+	CVE       types.String   `tfsdk:"cve"`
+	Platform  types.String   `tfsdk:"platform"`
+	Type      types.String   `tfsdk:"type"`
+	Port      types.Int64    `tfsdk:"port"`
+	Source    types.String   `tfsdk:"source"`
+	CountOnly types.Bool     `tfsdk:"count_only"`
+	MaxPages  types.Int64    `tfsdk:"max_pages"`
+	Total     types.Int64    `tfsdk:"total"`
+	Exploits  []ExploitModel `tfsdk:"exploits"`
+}
+
+// ExploitModel represents a public exploit
+type ExploitModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Description types.String   `tfsdk:"description"`
+	Source      types.String   `tfsdk:"source"`
+	CVEs        []types.String `tfsdk:"cves"`
+	Date        types.String   `tfsdk:"date"`
+	Platform    types.String   `tfsdk:"platform"`
+	Type        types.String   `tfsdk:"type"`
+	Port        types.Int64    `tfsdk:"port"`
+}
+
+func NewShodanExploitsDataSource() datasource.DataSource {
+	return &ShodanExploitsDataSource{}
+}
+
+func (d *ShodanExploitsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exploits"
+}
+
+func (d *ShodanExploitsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Search the Shodan Exploits API for public exploits. At least one of cve, platform, type, port and source must be set.",
+		Attributes: map[string]schema.Attribute{
+			"cve": schema.StringAttribute{
+				Description: "Only return exploits for this CVE ID (e.g., 'CVE-2021-44228').",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.CVEID(),
+					stringvalidator.AtLeastOneOf(
+						path.MatchRoot("platform"),
+						path.MatchRoot("type"),
+						path.MatchRoot("port"),
+						path.MatchRoot("source"),
+					),
+				},
+			},
+			"platform": schema.StringAttribute{
+				Description: "Only return exploits for this platform (e.g., 'linux', 'windows' or 'php').",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotBlank(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return exploits of this type (e.g., 'remote', 'local', 'webapps' or 'dos').",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotBlank(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "Only return exploits targeting this port.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					validators.Port(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "Only return exploits from this source (e.g., 'ExploitDB' or 'Metasploit').",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotBlank(),
+				},
+			},
+			"count_only": schema.BoolAttribute{
+				Description: "Only count the matching exploits, leaving exploits empty. Defaults to false.",
+				Optional:    true,
+			},
+			"max_pages": schema.Int64Attribute{
+				Description: fmt.Sprintf("The most pages of results to fetch. Defaults to %d.", defaultExploitPages),
+				Optional:    true,
+				Validators: []validator.Int64{
+					validators.Pages(),
+				},
+			},
+			"total": schema.Int64Attribute{
+				Description: "The total number of matching exploits, which may exceed the number returned.",
+				Computed:    true,
+			},
+			"exploits": schema.ListNestedAttribute{
+				Description: "The matching exploits.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the exploit within its source.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the exploit.",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "Where the exploit was found, such as 'ExploitDB'.",
+							Computed:    true,
+						},
+						"cves": schema.ListAttribute{
+							Description: "CVE IDs of the vulnerabilities the exploit targets.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"date": schema.StringAttribute{
+							Description: "When the exploit was published.",
+							Computed:    true,
+						},
+						"platform": schema.StringAttribute{
+							Description: "The platform the exploit targets.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of exploit.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "The port the exploit targets, if any.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ShodanExploitsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShodanExploitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanExploitsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := ExploitQuery{
+		CVE:      data.CVE.ValueString(),
+		Platform: data.Platform.ValueString(),
+		Type:     data.Type.ValueString(),
+		Port:     data.Port.ValueInt64(),
+		Source:   data.Source.ValueString(),
+	}
+
+	data.Exploits = []ExploitModel{}
+
+	if data.CountOnly.ValueBool() {
+		total, err := d.client.CountExploits(query)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error counting exploits",
+				fmt.Sprintf("Could not count exploits matching %q: %s", query.String(), err.Error()),
+			)
+			return
+		}
+
+		data.Total = types.Int64Value(total)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	maxPages := defaultExploitPages
+	if !data.MaxPages.IsNull() {
+		maxPages = int(data.MaxPages.ValueInt64())
+	}
+
+	// Search the Exploits API
+	exploits, total, err := d.client.SearchExploits(query, maxPages)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error searching exploits",
+			fmt.Sprintf("Could not search exploits matching %q: %s", query.String(), err.Error()),
+		)
+		return
+	}
+
+	data.Total = types.Int64Value(total)
+	for _, exploit := range exploits {
+		port := types.Int64Null()
+		if exploit.Port > 0 {
+			port = types.Int64Value(exploit.Port)
+		}
+
+		data.Exploits = append(data.Exploits, ExploitModel{
+			ID:          types.StringValue(string(exploit.ID)),
+			Description: types.StringValue(exploit.Description),
+			Source:      types.StringValue(exploit.Source),
+			CVEs:        stringValues(exploit.CVEs),
+			Date:        types.StringValue(exploit.Date),
+			Platform:    types.StringValue(exploit.Platform),
+			Type:        types.StringValue(exploit.Type),
+			Port:        port,
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
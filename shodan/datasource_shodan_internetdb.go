@@ -0,0 +1,148 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanInternetDBDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanInternetDBDataSource{}
+)
+
+// ShodanInternetDBDataSource is the data source implementation.
+type ShodanInternetDBDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanInternetDBDataSourceModel describes the data source data model.
+type ShodanInternetDBDataSourceModel struct {
+	IP        types.String   `tfsdk:"ip"`
+	Found     types.Bool     `tfsdk:"found"`
+	Ports     []types.Int64  `tfsdk:"ports"`
+	CPEs      []types.String `tfsdk:"cpes"`
+	Hostnames []types.String `tfsdk:"hostnames"`
+	Tags      []types.String `tfsdk:"tags"`
+	Vulns     []types.String `tfsdk:"vulns"`
+}
+
+func NewShodanInternetDBDataSource() datasource.DataSource {
+	return &ShodanInternetDBDataSource{}
+}
+
+func (d *ShodanInternetDBDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internetdb"
+}
+
+func (d *ShodanInternetDBDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Look up the open ports, software and vulnerabilities of an IP address using the free InternetDB API. No API key or query credits are needed.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Description: "The IP address to look up (e.g., '198.51.100.1').",
+				Required:    true,
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+			},
+			"found": schema.BoolAttribute{
+				Description: "Whether InternetDB has any information on the IP address.",
+				Computed:    true,
+			},
+			"ports": schema.ListAttribute{
+				Description: "Open ports seen on the IP address.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"cpes": schema.ListAttribute{
+				Description: "CPEs of the software seen on the IP address.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"hostnames": schema.ListAttribute{
+				Description: "Hostnames of the IP address.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags describing the IP address, such as 'cloud' or 'vpn'.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"vulns": schema.ListAttribute{
+				Description: "CVE IDs of the vulnerabilities the IP address may be affected by.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ShodanInternetDBDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	// InternetDB needs no API key, so missingAPIKey is not checked here
+	d.client = client
+}
+
+func (d *ShodanInternetDBDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanInternetDBDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the IP through InternetDB
+	host, err := d.client.GetInternetDBHost(data.IP.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading InternetDB information",
+			fmt.Sprintf("Could not read InternetDB information for %s: %s", data.IP.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// An IP InternetDB has never seen has no ports, software or vulnerabilities
+	if host == nil {
+		host = &InternetDBHost{}
+	}
+
+	data.Found = types.BoolValue(host.IP != "")
+
+	data.Ports = make([]types.Int64, len(host.Ports))
+	for i, port := range host.Ports {
+		data.Ports[i] = types.Int64Value(port)
+	}
+
+	data.CPEs = stringValues(host.CPEs)
+	data.Hostnames = stringValues(host.Hostnames)
+	data.Tags = stringValues(host.Tags)
+	data.Vulns = stringValues(host.Vulns)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
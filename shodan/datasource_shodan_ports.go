@@ -0,0 +1,93 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanPortsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanPortsDataSource{}
+)
+
+// ShodanPortsDataSource is the data source implementation.
+type ShodanPortsDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanPortsDataSourceModel describes the data source data model.
+type ShodanPortsDataSourceModel struct {
+	Ports []types.Int64 `tfsdk:"ports"`
+}
+
+func NewShodanPortsDataSource() datasource.DataSource {
+	return &ShodanPortsDataSource{}
+}
+
+func (d *ShodanPortsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ports"
+}
+
+func (d *ShodanPortsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the ports Shodan's crawlers look for.",
+		Attributes: map[string]schema.Attribute{
+			"ports": schema.ListAttribute{
+				Description: "The ports Shodan's crawlers look for, in ascending order.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ShodanPortsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShodanPortsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanPortsDataSourceModel
+
+	// Get the port list from Shodan
+	ports, err := d.client.ListPorts()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ports",
+			fmt.Sprintf("Could not read the ports Shodan crawls: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Ports = make([]types.Int64, len(ports))
+	for i, port := range ports {
+		data.Ports[i] = types.Int64Value(port)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,102 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanProtocolsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanProtocolsDataSource{}
+)
+
+// ShodanProtocolsDataSource is the data source implementation.
+type ShodanProtocolsDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanProtocolsDataSourceModel describes the data source data model.
+type ShodanProtocolsDataSourceModel struct {
+	Names     []types.String `tfsdk:"names"`
+	Protocols types.Map      `tfsdk:"protocols"`
+}
+
+func NewShodanProtocolsDataSource() datasource.DataSource {
+	return &ShodanProtocolsDataSource{}
+}
+
+func (d *ShodanProtocolsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_protocols"
+}
+
+func (d *ShodanProtocolsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the protocols that on-demand scans can use.",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Description: "Names of all available protocols, in ascending order.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"protocols": schema.MapAttribute{
+				Description: "The description of each protocol, keyed by protocol name.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ShodanProtocolsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShodanProtocolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanProtocolsDataSourceModel
+
+	// Get the protocol list from Shodan
+	protocols, err := d.client.ListProtocols()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading protocols",
+			fmt.Sprintf("Could not read the protocols available to scans: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Names = stringValues(slices.Sorted(maps.Keys(protocols)))
+
+	protocolsValue, diags := types.MapValueFrom(ctx, types.StringType, protocols)
+	resp.Diagnostics.Append(diags...)
+	data.Protocols = protocolsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,238 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultQueryDirectoryPages is the number of pages fetched when max_pages is
+// unset. Each page holds 10 queries.
+const defaultQueryDirectoryPages = 1
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanQueryDirectoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanQueryDirectoryDataSource{}
+)
+
+// ShodanQueryDirectoryDataSource is the data source implementation.
+type ShodanQueryDirectoryDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanQueryDirectoryDataSourceModel describes the data source data model.
+type ShodanQueryDirectoryDataSourceModel struct {
+	Search   types.String      `tfsdk:"search"`
+	Tags     []types.String    `tfsdk:"tags"`
+	Sort     types.String      `tfsdk:"sort"`
+	Order    types.String      `tfsdk:"order"`
+	MaxPages types.Int64       `tfsdk:"max_pages"`
+	Total    types.Int64       `tfsdk:"total"`
+	Queries  []SavedQueryModel `tfsdk:"queries"`
+}
+
+// SavedQueryModel represents a query from the query directory
+type SavedQueryModel struct {
+	Title       types.String   `tfsdk:"title"`
+	Description types.String   `tfsdk:"description"`
+	Query       types.String   `tfsdk:"query"`
+	Votes       types.Int64    `tfsdk:"votes"`
+	Timestamp   types.String   `tfsdk:"timestamp"`
+	Tags        []types.String `tfsdk:"tags"`
+}
+
+func NewShodanQueryDirectoryDataSource() datasource.DataSource {
+	return &ShodanQueryDirectoryDataSource{}
+}
+
+func (d *ShodanQueryDirectoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query_directory"
+}
+
+func (d *ShodanQueryDirectoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists or searches the queries saved to the Shodan query directory.",
+		Attributes: map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				Description: "Only return saved queries matching these keywords (e.g., 'webcam').",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NotBlank(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Description: "Only return saved queries with at least one of these tags.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.NotBlank()),
+				},
+			},
+			"sort": schema.StringAttribute{
+				Description: "Sort the saved queries by 'votes' or 'timestamp'. Cannot be used with search or tags.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("votes", "timestamp"),
+					stringvalidator.ConflictsWith(path.MatchRoot("search"), path.MatchRoot("tags")),
+				},
+			},
+			"order": schema.StringAttribute{
+				Description: "Sort the saved queries in 'asc' or 'desc' order. Cannot be used with search or tags.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("asc", "desc"),
+					stringvalidator.ConflictsWith(path.MatchRoot("search"), path.MatchRoot("tags")),
+				},
+			},
+			"max_pages": schema.Int64Attribute{
+				Description: fmt.Sprintf("The most pages of 10 saved queries to fetch, for each tag when tags is set. Defaults to %d.", defaultQueryDirectoryPages),
+				Optional:    true,
+				Validators: []validator.Int64{
+					validators.Pages(),
+				},
+			},
+			"total": schema.Int64Attribute{
+				Description: "The number of saved queries Shodan reports as matching, which may exceed the number returned. With several tags, the matches for each tag are added up.",
+				Computed:    true,
+			},
+			"queries": schema.ListNestedAttribute{
+				Description: "The saved queries.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							Description: "The title of the saved query.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the saved query.",
+							Computed:    true,
+						},
+						"query": schema.StringAttribute{
+							Description: "The search query.",
+							Computed:    true,
+						},
+						"votes": schema.Int64Attribute{
+							Description: "The number of votes the saved query has received.",
+							Computed:    true,
+						},
+						"timestamp": schema.StringAttribute{
+							Description: "When the query was saved.",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "Tags of the saved query.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ShodanQueryDirectoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShodanQueryDirectoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanQueryDirectoryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxPages := defaultQueryDirectoryPages
+	if !data.MaxPages.IsNull() {
+		maxPages = int(data.MaxPages.ValueInt64())
+	}
+
+	listing := SavedQueryListing{
+		Search: data.Search.ValueString(),
+		Sort:   data.Sort.ValueString(),
+		Order:  data.Order.ValueString(),
+	}
+
+	// Shodan filters by a single tag, so each tag is searched separately
+	listings := []SavedQueryListing{listing}
+	if tags := valueStrings(data.Tags); len(tags) > 0 {
+		slices.Sort(tags)
+		listings = make([]SavedQueryListing, len(tags))
+		for i, tag := range tags {
+			listings[i] = listing
+			listings[i].Tag = tag
+		}
+	}
+
+	var queries []SavedQuery
+	var total int64
+	for _, listing := range listings {
+		// Get the saved queries from Shodan
+		matches, matchTotal, err := d.client.ListSavedQueries(listing, maxPages)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading the query directory",
+				fmt.Sprintf("Could not read saved queries: %s", err.Error()),
+			)
+			return
+		}
+
+		total += matchTotal
+		for _, match := range matches {
+			// Queries with several of the tags are only returned once
+			if !slices.ContainsFunc(queries, func(q SavedQuery) bool { return q.Title == match.Title && q.Query == match.Query }) {
+				queries = append(queries, match)
+			}
+		}
+	}
+
+	data.Total = types.Int64Value(total)
+	data.Queries = []SavedQueryModel{}
+	for _, query := range queries {
+		data.Queries = append(data.Queries, SavedQueryModel{
+			Title:       types.StringValue(query.Title),
+			Description: types.StringValue(query.Description),
+			Query:       types.StringValue(query.Query),
+			Votes:       types.Int64Value(query.Votes),
+			Timestamp:   types.StringValue(query.Timestamp),
+			Tags:        stringValues(query.Tags),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,146 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultQueryTags is the number of tags returned when size is unset
+const defaultQueryTags = 10
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanQueryTagsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanQueryTagsDataSource{}
+)
+
+// ShodanQueryTagsDataSource is the data source implementation.
+type ShodanQueryTagsDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanQueryTagsDataSourceModel describes the data source data model.
+type ShodanQueryTagsDataSourceModel struct {
+	Size  types.Int64     `tfsdk:"size"`
+	Names []types.String  `tfsdk:"names"`
+	Tags  []QueryTagModel `tfsdk:"tags"`
+}
+
+// QueryTagModel represents a tag used in the query directory
+type QueryTagModel struct {
+	Value types.String `tfsdk:"value"`
+	Count types.Int64  `tfsdk:"count"`
+}
+
+func NewShodanQueryTagsDataSource() datasource.DataSource {
+	return &ShodanQueryTagsDataSource{}
+}
+
+func (d *ShodanQueryTagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query_tags"
+}
+
+func (d *ShodanQueryTagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the most popular tags in the Shodan query directory.",
+		Attributes: map[string]schema.Attribute{
+			"size": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of tags to return, between 1 and %d. Defaults to %d.", validators.MaxQueryTags, defaultQueryTags),
+				Optional:    true,
+				Validators: []validator.Int64{
+					validators.QueryTags(),
+				},
+			},
+			"names": schema.ListAttribute{
+				Description: "The tags, most used first.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"tags": schema.ListNestedAttribute{
+				Description: "The tags with their usage, most used first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Description: "The tag.",
+							Computed:    true,
+						},
+						"count": schema.Int64Attribute{
+							Description: "The number of saved queries with the tag.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ShodanQueryTagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShodanQueryTagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanQueryTagsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	size := defaultQueryTags
+	if !data.Size.IsNull() {
+		size = int(data.Size.ValueInt64())
+	}
+
+	// Get the popular tags from Shodan
+	tags, err := d.client.ListQueryTags(size)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading query tags",
+			fmt.Sprintf("Could not read the query directory tags: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Names = make([]types.String, len(tags))
+	data.Tags = make([]QueryTagModel, len(tags))
+	for i, tag := range tags {
+		data.Names[i] = types.StringValue(tag.Value)
+		data.Tags[i] = QueryTagModel{
+			Value: types.StringValue(tag.Value),
+			Count: types.Int64Value(tag.Count),
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,131 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource                     = &ShodanQueryTokensDataSource{}
+	_ datasource.DataSourceWithConfigure        = &ShodanQueryTokensDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &ShodanQueryTokensDataSource{}
+)
+
+// ShodanQueryTokensDataSource is the data source implementation.
+type ShodanQueryTokensDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanQueryTokensDataSourceModel describes the data source data model.
+type ShodanQueryTokensDataSourceModel struct {
+	Query      types.String   `tfsdk:"query"`
+	Text       types.String   `tfsdk:"text"`
+	Filters    []types.String `tfsdk:"filters"`
+	Attributes types.Map      `tfsdk:"attributes"`
+}
+
+func NewShodanQueryTokensDataSource() datasource.DataSource {
+	return &ShodanQueryTokensDataSource{}
+}
+
+func (d *ShodanQueryTokensDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query_tokens"
+}
+
+func (d *ShodanQueryTokensDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Parses a Shodan search query into its text, filters and attributes without running it. Queries Shodan cannot parse, or that use unknown filters, fail the plan.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Description: "The search query to parse (e.g., 'apache country:DE port:443').",
+				Required:    true,
+				Validators: []validator.String{
+					validators.Query(),
+				},
+			},
+			"text": schema.StringAttribute{
+				Description: "The free-text part of the query, without filters.",
+				Computed:    true,
+			},
+			"filters": schema.ListAttribute{
+				Description: "Names of the filters the query uses.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"attributes": schema.MapAttribute{
+				Description: "The values Shodan parsed out of the filters, keyed by attribute (e.g., 'ports').",
+				ElementType: types.ListType{ElemType: types.StringType},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ShodanQueryTokensDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShodanQueryTokensDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		newQueryValidator(d.client, path.Root("query")),
+	}
+}
+
+func (d *ShodanQueryTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanQueryTokensDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the query through Shodan
+	tokens, err := d.client.ParseQuery(data.Query.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing query",
+			fmt.Sprintf("Could not parse %q through Shodan: %s", data.Query.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.Text = types.StringValue(tokens.String)
+	data.Filters = stringValues(tokens.Filters)
+
+	attributesValue, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, tokens.AttributeValues())
+	resp.Diagnostics.Append(diags...)
+	data.Attributes = attributesValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,90 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanSearchFacetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanSearchFacetsDataSource{}
+)
+
+// ShodanSearchFacetsDataSource is the data source implementation.
+type ShodanSearchFacetsDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanSearchFacetsDataSourceModel describes the data source data model.
+type ShodanSearchFacetsDataSourceModel struct {
+	Facets []types.String `tfsdk:"facets"`
+}
+
+func NewShodanSearchFacetsDataSource() datasource.DataSource {
+	return &ShodanSearchFacetsDataSource{}
+}
+
+func (d *ShodanSearchFacetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_facets"
+}
+
+func (d *ShodanSearchFacetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the facets that Shodan searches can summarise results by.",
+		Attributes: map[string]schema.Attribute{
+			"facets": schema.ListAttribute{
+				Description: "Names of all search facets, such as 'country' or 'product', in ascending order.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ShodanSearchFacetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShodanSearchFacetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanSearchFacetsDataSourceModel
+
+	// Get the search facets from Shodan
+	facets, err := d.client.ListSearchFacets()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading search facets",
+			fmt.Sprintf("Could not read the search facets: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Facets = stringValues(facets)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,90 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &ShodanSearchFiltersDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShodanSearchFiltersDataSource{}
+)
+
+// ShodanSearchFiltersDataSource is the data source implementation.
+type ShodanSearchFiltersDataSource struct {
+	client *ShodanClient
+}
+
+// ShodanSearchFiltersDataSourceModel describes the data source data model.
+type ShodanSearchFiltersDataSourceModel struct {
+	Filters []types.String `tfsdk:"filters"`
+}
+
+func NewShodanSearchFiltersDataSource() datasource.DataSource {
+	return &ShodanSearchFiltersDataSource{}
+}
+
+func (d *ShodanSearchFiltersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_filters"
+}
+
+func (d *ShodanSearchFiltersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the filters that Shodan search queries can use.",
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.ListAttribute{
+				Description: "Names of all search filters, such as 'port' or 'org', in ascending order.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ShodanSearchFiltersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ShodanSearchFiltersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ShodanSearchFiltersDataSourceModel
+
+	// Get the search filters from Shodan
+	filters, err := d.client.ListSearchFilters()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading search filters",
+			fmt.Sprintf("Could not read the search filters: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Filters = stringValues(filters)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,282 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ ephemeral.EphemeralResource              = &ShodanHostEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ShodanHostEphemeralResource{}
+)
+
+// ShodanHostEphemeralResource is the ephemeral resource implementation.
+type ShodanHostEphemeralResource struct {
+	client *ShodanClient
+}
+
+// ShodanHostEphemeralResourceModel describes the ephemeral resource data model.
+type ShodanHostEphemeralResourceModel struct {
+	IP          types.String   `tfsdk:"ip"`
+	History     types.Bool     `tfsdk:"history"`
+	Found       types.Bool     `tfsdk:"found"`
+	Hostnames   []types.String `tfsdk:"hostnames"`
+	Domains     []types.String `tfsdk:"domains"`
+	Ports       []types.Int64  `tfsdk:"ports"`
+	Tags        []types.String `tfsdk:"tags"`
+	Vulns       []types.String `tfsdk:"vulns"`
+	Org         types.String   `tfsdk:"org"`
+	ISP         types.String   `tfsdk:"isp"`
+	ASN         types.String   `tfsdk:"asn"`
+	OS          types.String   `tfsdk:"os"`
+	CountryCode types.String   `tfsdk:"country_code"`
+	City        types.String   `tfsdk:"city"`
+	LastUpdate  types.String   `tfsdk:"last_update"`
+	Services    []ServiceModel `tfsdk:"services"`
+}
+
+// ServiceModel represents a banner collected from a service
+type ServiceModel struct {
+	IP        types.String   `tfsdk:"ip"`
+	Port      types.Int64    `tfsdk:"port"`
+	Transport types.String   `tfsdk:"transport"`
+	Hostnames []types.String `tfsdk:"hostnames"`
+	Org       types.String   `tfsdk:"org"`
+	Product   types.String   `tfsdk:"product"`
+	Version   types.String   `tfsdk:"version"`
+	Timestamp types.String   `tfsdk:"timestamp"`
+	Data      types.String   `tfsdk:"data"`
+}
+
+// newServiceModel converts a service banner into its model
+func newServiceModel(service Service) ServiceModel {
+	return ServiceModel{
+		IP:        types.StringValue(service.IP),
+		Port:      types.Int64Value(service.Port),
+		Transport: optionalString(service.Transport),
+		Hostnames: stringValues(service.Hostnames),
+		Org:       optionalString(service.Org),
+		Product:   optionalString(service.Product),
+		Version:   optionalString(service.Version),
+		Timestamp: optionalString(service.Timestamp),
+		Data:      types.StringValue(service.Data),
+	}
+}
+
+// serviceAttributes returns the schema of a service banner, shared by the
+// shodan_host and shodan_search ephemeral resources
+func serviceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"ip": schema.StringAttribute{
+			Description: "The IP address of the host running the service.",
+			Computed:    true,
+		},
+		"port": schema.Int64Attribute{
+			Description: "The port the service listens on.",
+			Computed:    true,
+		},
+		"transport": schema.StringAttribute{
+			Description: "The transport protocol, either 'tcp' or 'udp'.",
+			Computed:    true,
+		},
+		"hostnames": schema.ListAttribute{
+			Description: "Hostnames associated with the service.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"org": schema.StringAttribute{
+			Description: "The organisation the IP address is assigned to.",
+			Computed:    true,
+		},
+		"product": schema.StringAttribute{
+			Description: "The product Shodan identified, if any.",
+			Computed:    true,
+		},
+		"version": schema.StringAttribute{
+			Description: "The version of the product Shodan identified, if any.",
+			Computed:    true,
+		},
+		"timestamp": schema.StringAttribute{
+			Description: "When Shodan collected the banner.",
+			Computed:    true,
+		},
+		"data": schema.StringAttribute{
+			Description: "The raw banner returned by the service.",
+			Computed:    true,
+		},
+	}
+}
+
+func NewShodanHostEphemeralResource() ephemeral.EphemeralResource {
+	return &ShodanHostEphemeralResource{}
+}
+
+func (e *ShodanHostEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host"
+}
+
+func (e *ShodanHostEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up everything Shodan has collected about an IP address without storing it in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Description: "The IPv4 or IPv6 address to look up.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+			},
+			"history": schema.BoolAttribute{
+				Description: "Whether to return every banner Shodan has collected instead of only the latest of each service. Defaults to false.",
+				Optional:    true,
+			},
+			"found": schema.BoolAttribute{
+				Description: "Whether Shodan has any information on the IP address.",
+				Computed:    true,
+			},
+			"hostnames": schema.ListAttribute{
+				Description: "Hostnames that resolve to the IP address.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"domains": schema.ListAttribute{
+				Description: "Domains of the hostnames.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"ports": schema.ListAttribute{
+				Description: "Open ports.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags describing the host, such as 'cloud' or 'vpn'.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"vulns": schema.ListAttribute{
+				Description: "CVE IDs of vulnerabilities the host may be affected by.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"org": schema.StringAttribute{
+				Description: "The organisation the IP address is assigned to.",
+				Computed:    true,
+			},
+			"isp": schema.StringAttribute{
+				Description: "The ISP providing the IP address.",
+				Computed:    true,
+			},
+			"asn": schema.StringAttribute{
+				Description: "The autonomous system number, such as 'AS15169'.",
+				Computed:    true,
+			},
+			"os": schema.StringAttribute{
+				Description: "The operating system Shodan identified, if any.",
+				Computed:    true,
+			},
+			"country_code": schema.StringAttribute{
+				Description: "The ISO 3166-1 alpha-2 code of the country the host is located in.",
+				Computed:    true,
+			},
+			"city": schema.StringAttribute{
+				Description: "The city the host is located in.",
+				Computed:    true,
+			},
+			"last_update": schema.StringAttribute{
+				Description: "When Shodan last updated the host.",
+				Computed:    true,
+			},
+			"services": schema.ListNestedAttribute{
+				Description: "The banners Shodan collected from services on the host.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serviceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (e *ShodanHostEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	e.client = client
+}
+
+func (e *ShodanHostEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ShodanHostEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the host through Shodan
+	host, err := e.client.GetHost(data.IP.ValueString(), data.History.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading host information",
+			fmt.Sprintf("Could not read Shodan host information for %s: %s", data.IP.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// An IP Shodan has never seen has no services
+	if host == nil {
+		host = &Host{}
+	}
+
+	data.Found = types.BoolValue(host.IP != "")
+	data.Hostnames = stringValues(host.Hostnames)
+	data.Domains = stringValues(host.Domains)
+	data.Tags = stringValues(host.Tags)
+	data.Vulns = stringValues(host.Vulns)
+	data.Org = optionalString(host.Org)
+	data.ISP = optionalString(host.ISP)
+	data.ASN = optionalString(host.ASN)
+	data.OS = optionalString(host.OS)
+	data.CountryCode = optionalString(host.CountryCode)
+	data.City = optionalString(host.City)
+	data.LastUpdate = optionalString(host.LastUpdate)
+
+	data.Ports = make([]types.Int64, len(host.Ports))
+	for i, port := range host.Ports {
+		data.Ports[i] = types.Int64Value(port)
+	}
+
+	data.Services = make([]ServiceModel, len(host.Services))
+	for i, service := range host.Services {
+		data.Services[i] = newServiceModel(service)
+	}
+
+	// Save data into the ephemeral result, which is never persisted
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,143 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultSearchPages is the number of pages fetched when max_pages is unset
+const defaultSearchPages = 1
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ ephemeral.EphemeralResource                     = &ShodanSearchEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure        = &ShodanSearchEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &ShodanSearchEphemeralResource{}
+)
+
+// ShodanSearchEphemeralResource is the ephemeral resource implementation.
+type ShodanSearchEphemeralResource struct {
+	client *ShodanClient
+}
+
+// ShodanSearchEphemeralResourceModel describes the ephemeral resource data model.
+type ShodanSearchEphemeralResourceModel struct {
+	Query    types.String   `tfsdk:"query"`
+	MaxPages types.Int64    `tfsdk:"max_pages"`
+	Total    types.Int64    `tfsdk:"total"`
+	Matches  []ServiceModel `tfsdk:"matches"`
+}
+
+func NewShodanSearchEphemeralResource() ephemeral.EphemeralResource {
+	return &ShodanSearchEphemeralResource{}
+}
+
+func (e *ShodanSearchEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search"
+}
+
+func (e *ShodanSearchEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches Shodan for services matching a query without storing the results in plan or state. " +
+			"Searches using filters or fetching more than one page consume query credits.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Description: "The search query, such as 'org:\"Example Corp\" port:443'.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.Query(),
+				},
+			},
+			"max_pages": schema.Int64Attribute{
+				Description: fmt.Sprintf("The most pages of 100 results to fetch. Defaults to %d.", defaultSearchPages),
+				Optional:    true,
+				Validators: []validator.Int64{
+					validators.Pages(),
+				},
+			},
+			"total": schema.Int64Attribute{
+				Description: "The total number of matching services, which may exceed the number returned.",
+				Computed:    true,
+			},
+			"matches": schema.ListNestedAttribute{
+				Description: "The banners of the matching services.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serviceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (e *ShodanSearchEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	e.client = client
+}
+
+func (e *ShodanSearchEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		newQueryValidator(e.client, path.Root("query")),
+	}
+}
+
+func (e *ShodanSearchEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ShodanSearchEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxPages := defaultSearchPages
+	if !data.MaxPages.IsNull() {
+		maxPages = int(data.MaxPages.ValueInt64())
+	}
+
+	// Search Shodan
+	services, total, err := e.client.SearchHosts(data.Query.ValueString(), maxPages)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error searching Shodan",
+			fmt.Sprintf("Could not search Shodan for %q: %s", data.Query.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.Total = types.Int64Value(total)
+	data.Matches = make([]ServiceModel, len(services))
+	for i, service := range services {
+		data.Matches[i] = newServiceModel(service)
+	}
+
+	// Save data into the ephemeral result, which is never persisted
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
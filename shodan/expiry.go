@@ -0,0 +1,64 @@
+package shodan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Policies for handling an alert that has passed its expiration time
+const (
+	// expiryPolicyRecreate removes the expired alert from state, so the next
+	// plan creates a replacement
+	expiryPolicyRecreate = "recreate"
+
+	// expiryPolicyIgnore keeps the expired alert in state without planning changes
+	expiryPolicyIgnore = "ignore"
+)
+
+// expiryPolicies lists the accepted values of the expiry_policy attribute
+var expiryPolicies = []string{expiryPolicyRecreate, expiryPolicyIgnore}
+
+// expirationValue converts the alert expiration into an RFC 3339 timestamp,
+// or null if the alert never expires.
+func expirationValue(alert *AlertResponse) types.String {
+	expiration, ok := alert.ExpirationTime()
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(expiration.UTC().Format(time.RFC3339))
+}
+
+// removeExpiredAlert applies the expiry policy to an alert read from Shodan.
+// It reports true if the alert has expired and was removed from state. The
+// alert itself is left alone: Read runs during plan, and Shodan removes
+// expired alerts on its own.
+func removeExpiredAlert(ctx context.Context, alert *AlertResponse, policy types.String, resp *resource.ReadResponse) bool {
+	if !alert.Expired(time.Now()) || policy.ValueString() == expiryPolicyIgnore {
+		return false
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Alert %s expired at %s, removing it from state", alert.ID, expirationValue(alert).ValueString()))
+
+	resp.State.RemoveResource(ctx)
+	return true
+}
+
+// removeDeletedAlert removes the resource from state if err reports that its
+// alert no longer exists on Shodan, which is how expired alerts end up once
+// Shodan removes them. It reports true if the resource was removed.
+func removeDeletedAlert(ctx context.Context, alertID string, err error, resp *resource.ReadResponse) bool {
+	if !errors.Is(err, ErrAlertNotFound) {
+		return false
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Alert %s no longer exists, removing it from state", alertID))
+
+	resp.State.RemoveResource(ctx)
+	return true
+}
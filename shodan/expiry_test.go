@@ -0,0 +1,133 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// resourceState returns state for a resource's schema with the given string
+// attributes set and every other attribute null
+func resourceState(t *testing.T, r resource.Resource, attrs map[string]string) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range attrs {
+		if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("could not set %s: %v", name, diags)
+		}
+	}
+	return state
+}
+
+func TestRemoveExpiredAlert(t *testing.T) {
+	tests := []struct {
+		name        string
+		expiration  interface{}
+		policy      types.String
+		wantRemoved bool
+	}{
+		{name: "never expires", policy: types.StringValue(expiryPolicyRecreate)},
+		{name: "not yet expired", expiration: "2999-01-01T00:00:00.000000", policy: types.StringValue(expiryPolicyRecreate)},
+		{name: "expired", expiration: "2020-01-01T00:00:00.000000", policy: types.StringValue(expiryPolicyRecreate), wantRemoved: true},
+		{name: "expired without a policy", expiration: "2020-01-01T00:00:00.000000", policy: types.StringNull(), wantRemoved: true},
+		{name: "expired and ignored", expiration: "2020-01-01T00:00:00.000000", policy: types.StringValue(expiryPolicyIgnore)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resource.ReadResponse{State: resourceState(t, &ShodanAlertResource{}, map[string]string{"id": "A1"})}
+			alert := &AlertResponse{ID: "A1", Expiration: tt.expiration}
+
+			removed := removeExpiredAlert(context.Background(), alert, tt.policy, resp)
+			if removed != tt.wantRemoved {
+				t.Errorf("removed = %t, want %t", removed, tt.wantRemoved)
+			}
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Errorf("state removed = %t, want %t", resp.State.Raw.IsNull(), tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestRemoveDeletedAlert(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantRemoved bool
+	}{
+		{name: "no error"},
+		{name: "other error", err: fmt.Errorf("API request failed with status 500")},
+		{name: "not found", err: fmt.Errorf("alert A1: %w", ErrAlertNotFound), wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resource.ReadResponse{State: resourceState(t, &ShodanAlertResource{}, map[string]string{"id": "A1"})}
+
+			removed := removeDeletedAlert(context.Background(), "A1", tt.err, resp)
+			if removed != tt.wantRemoved {
+				t.Errorf("removed = %t, want %t", removed, tt.wantRemoved)
+			}
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Errorf("state removed = %t, want %t", resp.State.Raw.IsNull(), tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestReadRemovesDeletedAlerts(t *testing.T) {
+	tests := []struct {
+		name  string
+		r     func(client *ShodanClient) resource.Resource
+		attrs map[string]string
+	}{
+		{
+			name:  "shodan_alert",
+			r:     func(client *ShodanClient) resource.Resource { return &ShodanAlertResource{client: client} },
+			attrs: map[string]string{"id": "A1", "name": "web"},
+		},
+		{
+			name:  "shodan_domain",
+			r:     func(client *ShodanClient) resource.Resource { return &ShodanDomainResource{client: client} },
+			attrs: map[string]string{"id": "A1", "domain": "example.com"},
+		},
+		{
+			name:  "shodan_alert_trigger_ignore",
+			r:     func(client *ShodanClient) resource.Resource { return &ShodanAlertTriggerIgnoreResource{client: client} },
+			attrs: map[string]string{"id": "A1/malware/198.51.100.1:80", "alert_id": "A1", "trigger": "malware", "ip": "198.51.100.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeShodan{failing: map[string]int{"GET /shodan/alert/A1/info": http.StatusNotFound}}
+			r := tt.r(newTestClient(t, fake))
+
+			state := resourceState(t, r, tt.attrs)
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Errorf("the resource was kept in state")
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package shodan
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ExploitQuery holds the filters of an Exploits API search. Empty filters are
+// left out of the query.
+type ExploitQuery struct {
+	CVE      string
+	Platform string
+	Type     string
+	Port     int64
+	Source   string
+}
+
+// String returns the query in Exploits API search syntax, such as
+// `cve:CVE-2021-44228 type:remote`
+func (q ExploitQuery) String() string {
+	var filters []string
+	add := func(name, value string) {
+		if value == "" {
+			return
+		}
+		filters = append(filters, formatQueryFilter(name, value))
+	}
+
+	add("cve", q.CVE)
+	add("platform", q.Platform)
+	add("type", q.Type)
+	if q.Port > 0 {
+		add("port", strconv.FormatInt(q.Port, 10))
+	}
+	add("source", q.Source)
+
+	return strings.Join(filters, " ")
+}
+
+// Exploit represents a public exploit as returned by the Exploits API
+type Exploit struct {
+	ID          exploitID `json:"id"`
+	Description string    `json:"description"`
+	Source      string    `json:"source"`
+	CVEs        []string  `json:"cve"`
+	Date        string    `json:"date"`
+	Platform    string    `json:"platform"`
+	Type        string    `json:"type"`
+	Port        int64     `json:"port"`
+}
+
+// exploitID accepts exploit IDs encoded as either strings or numbers, since
+// the Exploits API uses both depending on the source
+type exploitID string
+
+func (id *exploitID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = exploitID(s)
+		return nil
+	}
+
+	var n json.Number
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&n); err != nil {
+		return fmt.Errorf("exploit ID must be a string or number: %w", err)
+	}
+	*id = exploitID(n.String())
+	return nil
+}
+
+// exploitResults represents a page of Exploits API search results
+type exploitResults struct {
+	Matches []Exploit `json:"matches"`
+	Total   int64     `json:"total"`
+}
+
+// SearchExploits retrieves the exploits matching query. Pages are fetched in
+// turn until every match has been retrieved or maxPages have been fetched.
+// The total number of matches is returned alongside them.
+func (c *ShodanClient) SearchExploits(query ExploitQuery, maxPages int) ([]Exploit, int64, error) {
+	var exploits []Exploit
+	var total int64
+	for page := 1; page <= maxPages; page++ {
+		params := url.Values{}
+		params.Set("key", c.ApiKey)
+		params.Set("query", query.String())
+		params.Set("page", strconv.Itoa(page))
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/search?%s", c.ExploitsURL, params.Encode()), nil)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var results exploitResults
+		if err := c.doJSON(req, &results); err != nil {
+			return nil, 0, err
+		}
+
+		exploits = append(exploits, results.Matches...)
+		total = results.Total
+
+		if len(results.Matches) == 0 || int64(len(exploits)) >= total {
+			break
+		}
+	}
+
+	return exploits, total, nil
+}
+
+// CountExploits returns the number of exploits matching query without
+// retrieving them
+func (c *ShodanClient) CountExploits(query ExploitQuery) (int64, error) {
+	params := url.Values{}
+	params.Set("key", c.ApiKey)
+	params.Set("query", query.String())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/count?%s", c.ExploitsURL, params.Encode()), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var results exploitResults
+	if err := c.doJSON(req, &results); err != nil {
+		return 0, err
+	}
+
+	return results.Total, nil
+}
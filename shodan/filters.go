@@ -0,0 +1,128 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// alertFiltersAttrTypes are the attribute types of the filters object, which
+// holds the non-IP filters that scope an alert. The IP filter is managed
+// through the network attribute.
+var alertFiltersAttrTypes = map[string]attr.Type{
+	"port":    types.SetType{ElemType: types.Int64Type},
+	"product": types.SetType{ElemType: types.StringType},
+	"tag":     types.SetType{ElemType: types.StringType},
+	"vuln":    types.SetType{ElemType: types.StringType},
+}
+
+// alertFilterElemTypes maps each typed filter key to its element type. The
+// attribute names match the keys used by the Shodan API.
+var alertFilterElemTypes = map[string]attr.Type{
+	"port":    types.Int64Type,
+	"product": types.StringType,
+	"tag":     types.StringType,
+	"vuln":    types.StringType,
+}
+
+// buildAlertFilters returns the filters payload for an alert. It starts from
+// the filters currently on the alert so keys the provider doesn't manage are
+// preserved, then sets the IP filter and every typed filter from the model.
+// A null filters object clears every typed filter.
+func buildAlertFilters(ctx context.Context, networks []string, filters types.Object, current map[string]interface{}) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := make(map[string]interface{}, len(current)+1)
+	for key, value := range current {
+		payload[key] = value
+	}
+	payload["ip"] = networks
+
+	// Without a known filters object the remote values are left as they are
+	if filters.IsUnknown() {
+		return payload, diags
+	}
+
+	if filters.IsNull() {
+		for key := range alertFilterElemTypes {
+			delete(payload, key)
+		}
+		return payload, diags
+	}
+
+	attrs := filters.Attributes()
+	for key := range alertFilterElemTypes {
+		set, ok := attrs[key].(types.Set)
+		if !ok || set.IsNull() || set.IsUnknown() {
+			delete(payload, key)
+			continue
+		}
+
+		if key == "port" {
+			var ports []int64
+			diags.Append(set.ElementsAs(ctx, &ports, false)...)
+			payload[key] = ports
+			continue
+		}
+
+		var values []string
+		diags.Append(set.ElementsAs(ctx, &values, false)...)
+		payload[key] = values
+	}
+
+	return payload, diags
+}
+
+// filtersFromAlert builds the filters object from an alert read from Shodan.
+// It is null if the alert has no typed filters and none were in the prior value.
+func filtersFromAlert(alert *AlertResponse, prior types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	found := false
+	attrs := make(map[string]attr.Value, len(alertFilterElemTypes))
+	for key, elemType := range alertFilterElemTypes {
+		attrs[key] = types.SetNull(elemType)
+
+		raw, ok := alert.Filters[key].([]interface{})
+		if !ok || len(raw) == 0 {
+			continue
+		}
+
+		values := make([]attr.Value, 0, len(raw))
+		for _, item := range raw {
+			switch v := item.(type) {
+			case float64:
+				if elemType == types.Int64Type {
+					values = append(values, types.Int64Value(int64(v)))
+					continue
+				}
+				values = append(values, types.StringValue(fmt.Sprint(v)))
+			case string:
+				if elemType == types.Int64Type {
+					diags.AddWarning(
+						"Unexpected alert filter value",
+						fmt.Sprintf("Alert %s has a non-numeric %s filter value %q, it was ignored.", alert.ID, key, v),
+					)
+					continue
+				}
+				values = append(values, types.StringValue(v))
+			}
+		}
+
+		set, d := types.SetValue(elemType, values)
+		diags.Append(d...)
+		attrs[key] = set
+		found = true
+	}
+
+	if !found && (prior.IsNull() || prior.IsUnknown()) {
+		return types.ObjectNull(alertFiltersAttrTypes), diags
+	}
+
+	object, d := types.ObjectValue(alertFiltersAttrTypes, attrs)
+	diags.Append(d...)
+	return object, diags
+}
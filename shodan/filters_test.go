@@ -0,0 +1,179 @@
+package shodan
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// filtersValue builds a filters object, with every attribute not given null
+func filtersValue(values map[string]attr.Value) types.Object {
+	attrs := make(map[string]attr.Value, len(alertFilterElemTypes))
+	for key, elemType := range alertFilterElemTypes {
+		attrs[key] = types.SetNull(elemType)
+		if value, ok := values[key]; ok {
+			attrs[key] = value
+		}
+	}
+	return types.ObjectValueMust(alertFiltersAttrTypes, attrs)
+}
+
+func TestBuildAlertFilters(t *testing.T) {
+	current := map[string]interface{}{
+		"ip":       []interface{}{"198.51.100.0/24"},
+		"hostname": []interface{}{"example.com"},
+		"port":     []interface{}{float64(22)},
+		"vuln":     []interface{}{"CVE-2014-0160"},
+	}
+
+	tests := []struct {
+		name    string
+		filters types.Object
+		want    map[string]interface{}
+	}{
+		{
+			name: "typed filters replace the remote values",
+			filters: filtersValue(map[string]attr.Value{
+				"port": types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(443)}),
+				"tag":  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ics")}),
+			}),
+			want: map[string]interface{}{
+				"ip":       []string{"203.0.113.0/24"},
+				"hostname": []interface{}{"example.com"},
+				"port":     []int64{443},
+				"tag":      []string{"ics"},
+			},
+		},
+		{
+			name:    "unknown filters leave the remote values",
+			filters: types.ObjectUnknown(alertFiltersAttrTypes),
+			want: map[string]interface{}{
+				"ip":       []string{"203.0.113.0/24"},
+				"hostname": []interface{}{"example.com"},
+				"port":     []interface{}{float64(22)},
+				"vuln":     []interface{}{"CVE-2014-0160"},
+			},
+		},
+		{
+			name:    "null filters clear the typed filters",
+			filters: types.ObjectNull(alertFiltersAttrTypes),
+			want: map[string]interface{}{
+				"ip":       []string{"203.0.113.0/24"},
+				"hostname": []interface{}{"example.com"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := buildAlertFilters(context.Background(), []string{"203.0.113.0/24"}, tt.filters, current)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, ok := current["tag"]; ok || len(current) != 4 {
+		t.Errorf("the current filters were modified: %v", current)
+	}
+}
+
+func TestFiltersFromAlert(t *testing.T) {
+	tests := []struct {
+		name         string
+		filters      map[string]interface{}
+		prior        types.Object
+		want         types.Object
+		wantWarnings int
+	}{
+		{
+			name: "typed filters",
+			filters: map[string]interface{}{
+				"ip":       []interface{}{"198.51.100.0/24"},
+				"hostname": []interface{}{"example.com"},
+				"port":     []interface{}{float64(443)},
+				"product":  []interface{}{"nginx", float64(5)},
+			},
+			prior: types.ObjectNull(alertFiltersAttrTypes),
+			want: filtersValue(map[string]attr.Value{
+				"port":    types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(443)}),
+				"product": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("nginx"), types.StringValue("5")}),
+			}),
+		},
+		{
+			name:    "no typed filters",
+			filters: map[string]interface{}{"ip": []interface{}{"198.51.100.0/24"}, "hostname": []interface{}{"example.com"}},
+			prior:   types.ObjectNull(alertFiltersAttrTypes),
+			want:    types.ObjectNull(alertFiltersAttrTypes),
+		},
+		{
+			name:    "typed filters removed outside Terraform",
+			filters: map[string]interface{}{"ip": []interface{}{"198.51.100.0/24"}},
+			prior: filtersValue(map[string]attr.Value{
+				"tag": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ics")}),
+			}),
+			want: filtersValue(nil),
+		},
+		{
+			name:         "non-numeric ports are ignored",
+			filters:      map[string]interface{}{"port": []interface{}{"https", float64(443)}},
+			prior:        types.ObjectNull(alertFiltersAttrTypes),
+			want:         filtersValue(map[string]attr.Value{"port": types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(443)})}),
+			wantWarnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := filtersFromAlert(&AlertResponse{ID: "A1", Filters: tt.filters}, tt.prior)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if len(diags.Warnings()) != tt.wantWarnings {
+				t.Errorf("got %d warnings, want %d", len(diags.Warnings()), tt.wantWarnings)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlertFiltersRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	alert := &AlertResponse{
+		ID: "A1",
+		Filters: map[string]interface{}{
+			"ip":       []interface{}{"198.51.100.0/24"},
+			"hostname": []interface{}{"example.com"},
+			"asn":      []interface{}{"AS15169"},
+			"vuln":     []interface{}{"CVE-2014-0160"},
+		},
+	}
+
+	filters, diags := filtersFromAlert(alert, types.ObjectNull(alertFiltersAttrTypes))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	got, diags := buildAlertFilters(ctx, []string{"198.51.100.0/24"}, filters, alert.Filters)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := map[string]interface{}{
+		"ip":       []string{"198.51.100.0/24"},
+		"hostname": []interface{}{"example.com"},
+		"asn":      []interface{}{"AS15169"},
+		"vuln":     []string{"CVE-2014-0160"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,66 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &ShodanBuildQueryFunction{}
+
+// ShodanBuildQueryFunction is the build_query function implementation.
+type ShodanBuildQueryFunction struct{}
+
+func NewShodanBuildQueryFunction() function.Function {
+	return &ShodanBuildQueryFunction{}
+}
+
+func (f *ShodanBuildQueryFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_query"
+}
+
+func (f *ShodanBuildQueryFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a Shodan search query from a map of filters",
+		Description: "Returns a search query with one name:value filter per map entry, such as `country:US product:\"Apache httpd\"`. " +
+			"Filters are sorted by name, and values containing spaces or quotes are quoted and escaped. " +
+			"Filter names are not checked against the filters Shodan supports; use the shodan_search_filters data source for that.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:        "filters",
+				Description: "Filter values keyed by filter name, such as { country = \"US\", port = \"443\" }.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ShodanBuildQueryFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values map[string]types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &values))
+	if resp.Error != nil {
+		return
+	}
+
+	filters := make(map[string]string, len(values))
+	for name, value := range values {
+		if value.IsNull() {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Filter %q is null.", name))
+			return
+		}
+		filters[name] = value.ValueString()
+	}
+
+	query, err := BuildQuery(filters)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, query))
+}
@@ -0,0 +1,66 @@
+package shodan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShodanBuildQueryFunctionRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters map[string]attr.Value
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "quoted and sorted",
+			filters: map[string]attr.Value{"product": types.StringValue("Apache httpd"), "country": types.StringValue("US")},
+			want:    `country:US product:"Apache httpd"`,
+		},
+		{
+			name:    "empty",
+			filters: map[string]attr.Value{},
+		},
+		{
+			name:    "null value",
+			filters: map[string]attr.Value{"country": types.StringNull()},
+			wantErr: true,
+		},
+		{
+			name:    "invalid name",
+			filters: map[string]attr.Value{"country code": types.StringValue("US")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.MapValueMust(types.StringType, tt.filters)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			NewShodanBuildQueryFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("got %s, want an error", resp.Result.Value())
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			if got := resp.Result.Value(); !got.Equal(types.StringValue(tt.want)) {
+				t.Errorf("got %s, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package shodan
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &ShodanCIDRAddressCountFunction{}
+
+// ShodanCIDRAddressCountFunction is the cidr_address_count function implementation.
+type ShodanCIDRAddressCountFunction struct{}
+
+func NewShodanCIDRAddressCountFunction() function.Function {
+	return &ShodanCIDRAddressCountFunction{}
+}
+
+func (f *ShodanCIDRAddressCountFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_address_count"
+}
+
+func (f *ShodanCIDRAddressCountFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Count the addresses in a CIDR prefix",
+		Description: "Returns the number of IP addresses in an IPv4 or IPv6 prefix, such as 256 for \"198.51.100.0/24\". A bare address counts as a single host.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The IPv4 or IPv6 prefix, such as \"198.51.100.0/24\".",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f *ShodanCIDRAddressCountFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseNetwork(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	count := new(big.Float).SetInt(addressCount(prefix))
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, count))
+}
@@ -0,0 +1,53 @@
+package shodan
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShodanCIDRAddressCountFunctionRun(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		want    string
+		wantErr bool
+	}{
+		{cidr: "198.51.100.0/24", want: "256"},
+		{cidr: "203.0.113.7", want: "1"},
+		{cidr: "::/0", want: "340282366920938463463374607431768211456"},
+		{cidr: "198.51.100.1/24", wantErr: true},
+		{cidr: "bogus", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cidr, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.cidr)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.NumberUnknown()),
+			}
+
+			NewShodanCIDRAddressCountFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("got %s, want an error", resp.Result.Value())
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			want, _ := new(big.Float).SetString(tt.want)
+			if got := resp.Result.Value(); !got.Equal(types.NumberValue(want)) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
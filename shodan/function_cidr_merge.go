@@ -0,0 +1,75 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &ShodanCIDRMergeFunction{}
+
+// ShodanCIDRMergeFunction is the cidr_merge function implementation.
+type ShodanCIDRMergeFunction struct{}
+
+func NewShodanCIDRMergeFunction() function.Function {
+	return &ShodanCIDRMergeFunction{}
+}
+
+func (f *ShodanCIDRMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_merge"
+}
+
+func (f *ShodanCIDRMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Collapse IP addresses and CIDR prefixes into the minimal set",
+		Description: "Returns the smallest list of prefixes covering exactly the given addresses and prefixes. " +
+			"Prefixes covered by another are dropped and adjacent prefixes are joined, so [\"10.0.0.0/25\", \"10.0.0.128/25\"] becomes [\"10.0.0.0/24\"]. " +
+			"The result is sorted, with IPv4 before IPv6, and bare addresses are returned as /32 or /128 prefixes.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "networks",
+				Description: "IPv4 or IPv6 addresses and prefixes, such as [\"198.51.100.0/24\", \"203.0.113.7\"].",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *ShodanCIDRMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var networks []types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &networks))
+	if resp.Error != nil {
+		return
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(networks))
+	for i, network := range networks {
+		if network.IsNull() {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Element %d is null.", i))
+			return
+		}
+
+		prefix, err := parseNetwork(network.ValueString())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, err.Error())
+			return
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	merged := mergeNetworks(prefixes)
+	result := make([]string, len(merged))
+	for i, prefix := range merged {
+		result[i] = prefix.String()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
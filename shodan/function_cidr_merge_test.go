@@ -0,0 +1,72 @@
+package shodan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShodanCIDRMergeFunctionRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		networks []attr.Value
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "merged",
+			networks: []attr.Value{types.StringValue("10.0.0.128/25"), types.StringValue("2001:db8::1"), types.StringValue("10.0.0.0/25")},
+			want:     []string{"10.0.0.0/24", "2001:db8::1/128"},
+		},
+		{
+			name:     "halves of the IPv4 space",
+			networks: []attr.Value{types.StringValue("0.0.0.0/1"), types.StringValue("128.0.0.0/1")},
+			want:     []string{"0.0.0.0/0"},
+		},
+		{
+			name: "empty",
+			want: []string{},
+		},
+		{
+			name:     "host bits set",
+			networks: []attr.Value{types.StringValue("10.0.0.1/24")},
+			wantErr:  true,
+		},
+		{
+			name:     "null element",
+			networks: []attr.Value{types.StringNull()},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.ListValueMust(types.StringType, tt.networks)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.ListUnknown(types.StringType)),
+			}
+
+			NewShodanCIDRMergeFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("got %s, want an error", resp.Result.Value())
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			want, _ := types.ListValueFrom(context.Background(), types.StringType, tt.want)
+			if got := resp.Result.Value(); !got.Equal(want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &ShodanIsPublicIPFunction{}
+
+// ShodanIsPublicIPFunction is the is_public_ip function implementation.
+type ShodanIsPublicIPFunction struct{}
+
+func NewShodanIsPublicIPFunction() function.Function {
+	return &ShodanIsPublicIPFunction{}
+}
+
+func (f *ShodanIsPublicIPFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_public_ip"
+}
+
+func (f *ShodanIsPublicIPFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether an IP address is publicly routable",
+		Description: "Returns true if the address is routable on the public internet, and so can be seen by Shodan. " +
+			"Private, loopback, link-local, multicast, carrier-grade NAT, documentation and other special-purpose addresses return false. " +
+			"IPv4-mapped IPv6 addresses are judged by their IPv4 address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ip",
+				Description: "The IPv4 or IPv6 address, such as \"198.51.100.1\".",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *ShodanIsPublicIPFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ip string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ip))
+	if resp.Error != nil {
+		return
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid IPv4 or IPv6 address.", ip))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, isPublicAddr(addr)))
+}
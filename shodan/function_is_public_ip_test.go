@@ -0,0 +1,52 @@
+package shodan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShodanIsPublicIPFunctionRun(t *testing.T) {
+	tests := []struct {
+		ip      string
+		want    bool
+		wantErr bool
+	}{
+		{ip: "8.8.8.8", want: true},
+		{ip: "::ffff:8.8.8.8", want: true},
+		{ip: "10.0.0.1"},
+		{ip: "2001:db8::1"},
+		{ip: "10.0.0.0/8", wantErr: true},
+		{ip: "bogus", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.ip)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.BoolUnknown()),
+			}
+
+			NewShodanIsPublicIPFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("got %s, want an error", resp.Result.Value())
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			if got := resp.Result.Value(); !got.Equal(types.BoolValue(tt.want)) {
+				t.Errorf("got %s, want %t", got, tt.want)
+			}
+		})
+	}
+}
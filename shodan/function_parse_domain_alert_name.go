@@ -0,0 +1,73 @@
+package shodan
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &ShodanParseDomainAlertNameFunction{}
+
+// ShodanParseDomainAlertNameFunction is the parse_domain_alert_name function implementation.
+type ShodanParseDomainAlertNameFunction struct{}
+
+// DomainAlertNameModel describes the object returned by parse_domain_alert_name.
+type DomainAlertNameModel struct {
+	Domain types.String `tfsdk:"domain"`
+	Name   types.String `tfsdk:"name"`
+}
+
+func NewShodanParseDomainAlertNameFunction() function.Function {
+	return &ShodanParseDomainAlertNameFunction{}
+}
+
+func (f *ShodanParseDomainAlertNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_domain_alert_name"
+}
+
+func (f *ShodanParseDomainAlertNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split a domain alert name into its domain and custom name",
+		Description: "Parses the name of an alert created by the shodan_domain resource, such as \"__domain: example.com (Production)\". " +
+			"Returns an object with the domain and the custom name, which is null when the alert has none. " +
+			"Both are null when the alert name does not follow the domain alert naming convention.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "alert_name",
+				Description: "The alert name, as returned by the shodan_alert data source.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"domain": types.StringType,
+				"name":   types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ShodanParseDomainAlertNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var alertName string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &alertName))
+	if resp.Error != nil {
+		return
+	}
+
+	result := DomainAlertNameModel{
+		Domain: types.StringNull(),
+		Name:   types.StringNull(),
+	}
+
+	if domain, name, ok := ParseDomainAlertName(alertName); ok {
+		result.Domain = types.StringValue(domain)
+		if name != "" {
+			result.Name = types.StringValue(name)
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,61 @@
+package shodan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShodanParseDomainAlertNameFunctionRun(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"domain": types.StringType,
+		"name":   types.StringType,
+	}
+
+	tests := []struct {
+		alertName string
+		want      map[string]attr.Value
+	}{
+		{
+			alertName: "__domain: example.com",
+			want:      map[string]attr.Value{"domain": types.StringValue("example.com"), "name": types.StringNull()},
+		},
+		{
+			alertName: `__domain: example.com (shop) [tf:{"description":"Marketing site"}]`,
+			want:      map[string]attr.Value{"domain": types.StringValue("example.com"), "name": types.StringValue("shop")},
+		},
+		{
+			alertName: "__domain: example.com [tf:not json]",
+			want:      map[string]attr.Value{"domain": types.StringNull(), "name": types.StringNull()},
+		},
+		{
+			alertName: "web",
+			want:      map[string]attr.Value{"domain": types.StringNull(), "name": types.StringNull()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alertName, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.alertName)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(attrTypes)),
+			}
+
+			NewShodanParseDomainAlertNameFunction().Run(context.Background(), req, resp)
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			want := types.ObjectValueMust(attrTypes, tt.want)
+			if got := resp.Result.Value(); !got.Equal(want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
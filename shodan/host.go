@@ -0,0 +1,97 @@
+package shodan
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Host represents everything Shodan has collected about an IP address
+type Host struct {
+	IP          string    `json:"ip_str"`
+	Hostnames   []string  `json:"hostnames"`
+	Domains     []string  `json:"domains"`
+	Ports       []int64   `json:"ports"`
+	Tags        []string  `json:"tags"`
+	Vulns       []string  `json:"vulns"`
+	Org         string    `json:"org"`
+	ISP         string    `json:"isp"`
+	ASN         string    `json:"asn"`
+	OS          string    `json:"os"`
+	CountryCode string    `json:"country_code"`
+	City        string    `json:"city"`
+	LastUpdate  string    `json:"last_update"`
+	Services    []Service `json:"data"`
+}
+
+// Service represents a banner Shodan collected from a service on a host
+type Service struct {
+	IP        string   `json:"ip_str"`
+	Port      int64    `json:"port"`
+	Transport string   `json:"transport"`
+	Hostnames []string `json:"hostnames"`
+	Org       string   `json:"org"`
+	Product   string   `json:"product"`
+	Version   string   `json:"version"`
+	Timestamp string   `json:"timestamp"`
+	Data      string   `json:"data"`
+}
+
+// GetHost retrieves the services Shodan has seen on an IP address. Only the
+// latest banner of each service is returned unless history is set. It returns
+// nil without an error when Shodan has no information on the IP.
+func (c *ShodanClient) GetHost(ip string, history bool) (*Host, error) {
+	params := url.Values{}
+	params.Set("key", c.ApiKey)
+	if history {
+		params.Set("history", "true")
+	}
+
+	var host Host
+	found, err := getOptionalJSON(c.HTTPClient, fmt.Sprintf("%s/shodan/host/%s?%s", c.BaseURL, ip, params.Encode()), &host)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return &host, nil
+}
+
+type searchResults struct {
+	Matches []Service `json:"matches"`
+	Total   int64     `json:"total"`
+}
+
+// SearchHosts retrieves the services matching a search query. Pages are
+// fetched in turn until every match has been retrieved or maxPages have been
+// fetched. The total number of matches is returned alongside them. Searches
+// using filters or fetching more than one page consume query credits.
+func (c *ShodanClient) SearchHosts(query string, maxPages int) ([]Service, int64, error) {
+	var services []Service
+	var total int64
+	for page := 1; page <= maxPages; page++ {
+		params := url.Values{}
+		params.Set("key", c.ApiKey)
+		params.Set("query", query)
+		params.Set("page", strconv.Itoa(page))
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/shodan/host/search?%s", c.BaseURL, params.Encode()), nil)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var results searchResults
+		if err := c.doJSON(req, &results); err != nil {
+			return nil, 0, err
+		}
+
+		services = append(services, results.Matches...)
+		total = results.Total
+
+		if len(results.Matches) == 0 || int64(len(services)) >= total {
+			break
+		}
+	}
+
+	return services, total, nil
+}
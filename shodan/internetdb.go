@@ -0,0 +1,27 @@
+package shodan
+
+import (
+	"fmt"
+)
+
+// InternetDBHost represents the response from the InternetDB API for an IP
+type InternetDBHost struct {
+	IP        string   `json:"ip"`
+	Ports     []int64  `json:"ports"`
+	CPEs      []string `json:"cpes"`
+	Hostnames []string `json:"hostnames"`
+	Tags      []string `json:"tags"`
+	Vulns     []string `json:"vulns"`
+}
+
+// GetInternetDBHost retrieves what InternetDB knows about an IP address. It
+// returns nil without an error when InternetDB has no information on the IP.
+func (c *ShodanClient) GetInternetDBHost(ip string) (*InternetDBHost, error) {
+	var host InternetDBHost
+	found, err := getOptionalJSON(c.InternetDBHTTPClient, fmt.Sprintf("%s/%s", c.InternetDBURL, ip), &host)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return &host, nil
+}
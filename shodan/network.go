@@ -0,0 +1,305 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseNetwork parses an IPv4 or IPv6 prefix. A bare address is treated as a
+// single host prefix. Prefixes with host bits set are rejected since Shodan
+// would silently monitor a different range than intended.
+func parseNetwork(network string) (netip.Prefix, error) {
+	if !strings.Contains(network, "/") {
+		addr, err := netip.ParseAddr(network)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("%q is not a valid IP address or CIDR prefix", network)
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(network)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR prefix: %s", network, err.Error())
+	}
+
+	if masked := prefix.Masked(); masked != prefix {
+		return netip.Prefix{}, fmt.Errorf("%q has host bits set, did you mean %q?", network, masked.String())
+	}
+
+	return prefix, nil
+}
+
+// canonicalNetwork returns the normalised form of a network, e.g. "10.0.0.1"
+// becomes "10.0.0.1/32" and "2001:DB8::/32" becomes "2001:db8::/32". Values
+// that cannot be parsed are returned unchanged.
+func canonicalNetwork(network string) string {
+	prefix, err := parseNetwork(network)
+	if err != nil {
+		return network
+	}
+	return prefix.String()
+}
+
+// canonicalNetworks returns the normalised form of each network
+func canonicalNetworks(networks []string) []string {
+	result := make([]string, len(networks))
+	for i, network := range networks {
+		result[i] = canonicalNetwork(network)
+	}
+	return result
+}
+
+// mergeNetworks collapses prefixes into the smallest equivalent set: prefixes
+// covered by another are dropped and adjacent halves are joined into their
+// parent. The result is sorted, with IPv4 before IPv6.
+func mergeNetworks(prefixes []netip.Prefix) []netip.Prefix {
+	sorted := slices.Clone(prefixes)
+	slices.SortFunc(sorted, func(a, b netip.Prefix) int {
+		if c := a.Addr().Compare(b.Addr()); c != 0 {
+			return c
+		}
+		return a.Bits() - b.Bits()
+	})
+
+	var merged []netip.Prefix
+	for _, prefix := range sorted {
+		if len(merged) > 0 && merged[len(merged)-1].Contains(prefix.Addr()) && merged[len(merged)-1].Bits() <= prefix.Bits() {
+			continue
+		}
+
+		// Join with the previous prefix while the two are halves of the same
+		// parent, which may in turn complete an earlier half
+		for len(merged) > 0 {
+			last := merged[len(merged)-1]
+			if last.Bits() != prefix.Bits() || prefix.Bits() == 0 {
+				break
+			}
+			parent, _ := last.Addr().Prefix(last.Bits() - 1)
+			if parent.Addr() != last.Addr() || !parent.Contains(prefix.Addr()) {
+				break
+			}
+			merged = merged[:len(merged)-1]
+			prefix = parent
+		}
+		merged = append(merged, prefix)
+	}
+
+	return merged
+}
+
+// addressCount returns the number of addresses in a prefix. IPv6 prefixes can
+// hold more than fits in 64 bits, so the count is a big.Int.
+func addressCount(prefix netip.Prefix) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+}
+
+// nonPublicNetworks are special-purpose ranges that are not routable on the
+// public internet, beyond those covered by the netip.Addr predicates
+var nonPublicNetworks = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved, including broadcast
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // local-use IPv4/IPv6 translation
+	netip.MustParsePrefix("100::/64"),        // discard-only
+	netip.MustParsePrefix("2001::/23"),       // IETF protocol assignments
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+	netip.MustParsePrefix("fc00::/7"),        // unique local
+}
+
+// isPublicAddr reports whether an address is routable on the public internet.
+// IPv4-mapped IPv6 addresses are judged by their IPv4 address.
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return false
+	}
+
+	return !slices.ContainsFunc(nonPublicNetworks, func(p netip.Prefix) bool { return p.Contains(addr) })
+}
+
+// alertNetworks returns the IP filter of an alert
+func alertNetworks(alert *AlertResponse) []string {
+	ipList, _ := alert.Filters["ip"].([]interface{})
+
+	var networks []string
+	for _, ip := range ipList {
+		if ipStr, ok := ip.(string); ok {
+			networks = append(networks, ipStr)
+		}
+	}
+	return networks
+}
+
+// preserveNetworks maps networks returned by Shodan back to the form used in
+// prior, so normalisation by Shodan doesn't produce a diff. If both describe
+// the same networks, prior is returned as is.
+func preserveNetworks(remote, prior []string) []string {
+	byCanonical := make(map[string]string, len(prior))
+	for _, network := range prior {
+		byCanonical[canonicalNetwork(network)] = network
+	}
+
+	result := make([]string, len(remote))
+	for i, network := range remote {
+		if original, ok := byCanonical[canonicalNetwork(network)]; ok {
+			result[i] = original
+		} else {
+			result[i] = network
+		}
+	}
+
+	if len(result) == len(prior) && !slices.ContainsFunc(result, func(n string) bool { return !slices.Contains(prior, n) }) {
+		return prior
+	}
+	return result
+}
+
+// networkOverlaps describes every pair of overlapping prefixes between a and b.
+// When b is nil, a is compared against itself.
+func networkOverlaps(a, b []string) []string {
+	self := b == nil
+	if self {
+		b = a
+	}
+
+	var overlaps []string
+	for i, first := range a {
+		p1, err := parseNetwork(first)
+		if err != nil {
+			continue
+		}
+
+		start := 0
+		if self {
+			start = i + 1
+		}
+		for _, second := range b[start:] {
+			p2, err := parseNetwork(second)
+			if err != nil {
+				continue
+			}
+			if p1.Overlaps(p2) {
+				overlaps = append(overlaps, fmt.Sprintf("%s overlaps %s", first, second))
+			}
+		}
+	}
+
+	return overlaps
+}
+
+// networkValidator validates that a string is an IPv4 or IPv6 prefix or address
+type networkValidator struct{}
+
+func (v networkValidator) Description(_ context.Context) string {
+	return "value must be a valid IPv4 or IPv6 CIDR prefix or address without host bits set"
+}
+
+func (v networkValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v networkValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseNetwork(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid network", err.Error())
+	}
+}
+
+// uniqueNetworksValidator rejects networks written in different forms that are
+// the same prefix, such as 203.0.113.1 and 203.0.113.1/32. Shodan stores one
+// canonical form, so only one of them could ever be preserved in state.
+type uniqueNetworksValidator struct{}
+
+func (v uniqueNetworksValidator) Description(_ context.Context) string {
+	return "networks must not repeat the same prefix in a different form"
+}
+
+func (v uniqueNetworksValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueNetworksValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var networks []types.String
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &networks, false)...)
+
+	seen := map[string]string{}
+	for _, network := range networks {
+		if network.IsNull() || network.IsUnknown() {
+			continue
+		}
+
+		prefix, err := parseNetwork(network.ValueString())
+		if err != nil {
+			continue
+		}
+
+		if first, ok := seen[prefix.String()]; ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(network),
+				"Duplicate network",
+				fmt.Sprintf("%s and %s are both %s. List the network once.", first, network.ValueString(), prefix),
+			)
+			continue
+		}
+		seen[prefix.String()] = network.ValueString()
+	}
+}
+
+// networkOverlapWarning warns when networks in the same set overlap, since
+// overlapping ranges are counted twice against the monitored IP quota.
+type networkOverlapWarning struct{}
+
+func (m networkOverlapWarning) Description(_ context.Context) string {
+	return "warns when networks in the set overlap"
+}
+
+func (m networkOverlapWarning) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m networkOverlapWarning) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var networks []types.String
+	resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &networks, false)...)
+
+	var known []string
+	for _, network := range networks {
+		if !network.IsUnknown() {
+			known = append(known, network.ValueString())
+		}
+	}
+
+	if overlaps := networkOverlaps(known, nil); len(overlaps) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Overlapping networks",
+			fmt.Sprintf("Overlapping networks are counted more than once against the monitored IP quota: %s.", strings.Join(overlaps, "; ")),
+		)
+	}
+}
@@ -0,0 +1,319 @@
+package shodan
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseNetwork(t *testing.T) {
+	tests := []struct {
+		network string
+		want    string
+		wantErr bool
+	}{
+		{network: "198.51.100.0/24", want: "198.51.100.0/24"},
+		{network: "203.0.113.7", want: "203.0.113.7/32"},
+		{network: "2001:DB8::/32", want: "2001:db8::/32"},
+		{network: "2001:db8::1", want: "2001:db8::1/128"},
+		{network: "0.0.0.0/0", want: "0.0.0.0/0"},
+		{network: "::/0", want: "::/0"},
+		{network: "198.51.100.1/24", wantErr: true},
+		{network: "2001:db8::1/32", wantErr: true},
+		{network: "198.51.100.0/33", wantErr: true},
+		{network: "not-an-ip", wantErr: true},
+		{network: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.network, func(t *testing.T) {
+			prefix, err := parseNetwork(tt.network)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseNetwork(%q) = %s, want an error", tt.network, prefix)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseNetwork(%q) returned an error: %s", tt.network, err)
+			}
+			if got := prefix.String(); got != tt.want {
+				t.Errorf("parseNetwork(%q) = %s, want %s", tt.network, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalNetwork(t *testing.T) {
+	tests := []struct {
+		network string
+		want    string
+	}{
+		{network: "10.0.0.1", want: "10.0.0.1/32"},
+		{network: "2001:DB8::/32", want: "2001:db8::/32"},
+		{network: "::ffff:10.0.0.1", want: "::ffff:10.0.0.1/128"},
+		{network: "10.0.0.1/8", want: "10.0.0.1/8"},
+		{network: "bogus", want: "bogus"},
+	}
+
+	for _, tt := range tests {
+		if got := canonicalNetwork(tt.network); got != tt.want {
+			t.Errorf("canonicalNetwork(%q) = %q, want %q", tt.network, got, tt.want)
+		}
+	}
+}
+
+func TestPreserveNetworks(t *testing.T) {
+	tests := []struct {
+		name   string
+		remote []string
+		prior  []string
+		want   []string
+	}{
+		{
+			name:   "normalised by Shodan",
+			remote: []string{"10.0.0.1/32", "2001:db8::/32"},
+			prior:  []string{"10.0.0.1", "2001:DB8::/32"},
+			want:   []string{"10.0.0.1", "2001:DB8::/32"},
+		},
+		{
+			name:   "reordered by Shodan",
+			remote: []string{"2001:db8::/32", "10.0.0.0/8"},
+			prior:  []string{"10.0.0.0/8", "2001:db8::/32"},
+			want:   []string{"10.0.0.0/8", "2001:db8::/32"},
+		},
+		{
+			name:   "changed outside Terraform",
+			remote: []string{"10.0.0.1/32", "192.0.2.0/24"},
+			prior:  []string{"10.0.0.1"},
+			want:   []string{"10.0.0.1", "192.0.2.0/24"},
+		},
+		{
+			name:   "removed outside Terraform",
+			remote: []string{"10.0.0.1/32"},
+			prior:  []string{"10.0.0.1", "::1"},
+			want:   []string{"10.0.0.1"},
+		},
+		{
+			name:   "no prior state",
+			remote: []string{"10.0.0.1/32"},
+			want:   []string{"10.0.0.1/32"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := preserveNetworks(tt.remote, tt.prior); !slices.Equal(got, tt.want) {
+				t.Errorf("preserveNetworks(%q, %q) = %q, want %q", tt.remote, tt.prior, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniqueNetworksValidator(t *testing.T) {
+	tests := []struct {
+		name     string
+		networks types.Set
+		wantErrs int
+	}{
+		{name: "null", networks: types.SetNull(types.StringType)},
+		{name: "unique", networks: stringSet("10.0.0.0/24", "10.0.0.1", "2001:db8::/32")},
+		{name: "bare address and host prefix", networks: stringSet("10.0.0.1", "10.0.0.1/32"), wantErrs: 1},
+		{name: "IPv6 case", networks: stringSet("2001:DB8::/32", "2001:db8::/32"), wantErrs: 1},
+		{name: "invalid networks are skipped", networks: stringSet("bogus", "10.0.0.1/24")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.SetRequest{Path: path.Root("network"), ConfigValue: tt.networks}
+			resp := &validator.SetResponse{}
+			uniqueNetworksValidator{}.ValidateSet(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrs {
+				t.Errorf("got %d errors %v, want %d", got, resp.Diagnostics, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestNetworkOverlaps(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want []string
+	}{
+		{
+			name: "self",
+			a:    []string{"10.0.0.0/8", "10.1.0.0/16", "192.0.2.0/24"},
+			want: []string{"10.0.0.0/8 overlaps 10.1.0.0/16"},
+		},
+		{
+			name: "IPv4 and IPv6 never overlap",
+			a:    []string{"0.0.0.0/0", "::/0"},
+		},
+		{
+			name: "IPv4-mapped IPv6 is not IPv4",
+			a:    []string{"10.0.0.1"},
+			b:    []string{"::ffff:10.0.0.1"},
+		},
+		{
+			name: "between lists",
+			a:    []string{"2001:db8::/32"},
+			b:    []string{"2001:db8:1::/48", "2001:db9::/32"},
+			want: []string{"2001:db8::/32 overlaps 2001:db8:1::/48"},
+		},
+		{
+			name: "invalid networks are skipped",
+			a:    []string{"bogus", "10.0.0.0/8"},
+			b:    []string{"10.0.0.0/8", "bogus"},
+			want: []string{"10.0.0.0/8 overlaps 10.0.0.0/8"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := networkOverlaps(tt.a, tt.b); !slices.Equal(got, tt.want) {
+				t.Errorf("networkOverlaps(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeNetworks(t *testing.T) {
+	tests := []struct {
+		name     string
+		networks []string
+		want     []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "adjacent halves",
+			networks: []string{"10.0.0.128/25", "10.0.0.0/25"},
+			want:     []string{"10.0.0.0/24"},
+		},
+		{
+			name:     "halves of the IPv4 space",
+			networks: []string{"128.0.0.0/1", "0.0.0.0/1"},
+			want:     []string{"0.0.0.0/0"},
+		},
+		{
+			name:     "halves of the IPv6 space",
+			networks: []string{"::/1", "8000::/1"},
+			want:     []string{"::/0"},
+		},
+		{
+			name:     "both address spaces stay apart",
+			networks: []string{"::/0", "0.0.0.0/1", "128.0.0.0/1"},
+			want:     []string{"0.0.0.0/0", "::/0"},
+		},
+		{
+			name:     "adjacent but not halves of the same parent",
+			networks: []string{"10.0.1.0/24", "10.0.2.0/24"},
+			want:     []string{"10.0.1.0/24", "10.0.2.0/24"},
+		},
+		{
+			name:     "joins cascade",
+			networks: []string{"10.0.0.0/24", "10.0.1.0/25", "10.0.1.128/25"},
+			want:     []string{"10.0.0.0/23"},
+		},
+		{
+			name:     "covered and duplicate prefixes",
+			networks: []string{"10.0.0.0/8", "10.1.2.3/32", "10.0.0.0/8", "10.0.0.0/16"},
+			want:     []string{"10.0.0.0/8"},
+		},
+		{
+			name:     "mixed families sorted IPv4 first",
+			networks: []string{"2001:db8::/33", "198.51.100.7/32", "2001:db8:8000::/33", "198.51.100.6/32"},
+			want:     []string{"198.51.100.6/31", "2001:db8::/32"},
+		},
+		{
+			name:     "IPv4-mapped IPv6 is not joined with IPv4",
+			networks: []string{"10.0.0.0/32", "::ffff:10.0.0.1/128"},
+			want:     []string{"10.0.0.0/32", "::ffff:10.0.0.1/128"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefixes := make([]netip.Prefix, len(tt.networks))
+			for i, network := range tt.networks {
+				prefixes[i] = netip.MustParsePrefix(network)
+			}
+
+			var got []string
+			for _, prefix := range mergeNetworks(prefixes) {
+				got = append(got, prefix.String())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("mergeNetworks(%q) = %q, want %q", tt.networks, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddressCount(t *testing.T) {
+	tests := []struct {
+		network string
+		want    string
+	}{
+		{network: "203.0.113.7/32", want: "1"},
+		{network: "198.51.100.0/24", want: "256"},
+		{network: "0.0.0.0/0", want: "4294967296"},
+		{network: "2001:db8::1/128", want: "1"},
+		{network: "2001:db8::/64", want: "18446744073709551616"},
+		{network: "::/0", want: "340282366920938463463374607431768211456"},
+	}
+
+	for _, tt := range tests {
+		if got := addressCount(netip.MustParsePrefix(tt.network)).String(); got != tt.want {
+			t.Errorf("addressCount(%s) = %s, want %s", tt.network, got, tt.want)
+		}
+	}
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "8.8.8.8", want: true},
+		{addr: "1.1.1.1", want: true},
+		{addr: "2606:4700::1111", want: true},
+		{addr: "::ffff:8.8.8.8", want: true},
+		{addr: "10.0.0.1"},
+		{addr: "172.16.0.1"},
+		{addr: "192.168.1.1"},
+		{addr: "127.0.0.1"},
+		{addr: "0.0.0.0"},
+		{addr: "100.64.0.1"},
+		{addr: "169.254.0.1"},
+		{addr: "192.0.2.1"},
+		{addr: "198.18.0.1"},
+		{addr: "198.51.100.1"},
+		{addr: "203.0.113.1"},
+		{addr: "224.0.0.1"},
+		{addr: "255.255.255.255"},
+		{addr: "::"},
+		{addr: "::1"},
+		{addr: "::ffff:10.0.0.1"},
+		{addr: "fe80::1"},
+		{addr: "fd00::1"},
+		{addr: "ff02::1"},
+		{addr: "2001:db8::1"},
+		{addr: "64:ff9b:1::1"},
+	}
+
+	for _, tt := range tests {
+		if got := isPublicAddr(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("isPublicAddr(%s) = %t, want %t", tt.addr, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package shodan
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SavedQuery represents a query saved to the Shodan query directory
+type SavedQuery struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Query       string   `json:"query"`
+	Votes       int64    `json:"votes"`
+	Timestamp   string   `json:"timestamp"`
+	Tags        []string `json:"tags"`
+}
+
+// SavedQueryListing holds the parameters for listing the query directory.
+// Sort and Order only apply when Search and Tag are empty.
+type SavedQueryListing struct {
+	// Search only returns queries matching these keywords
+	Search string
+
+	// Tag only returns queries with this tag
+	Tag string
+
+	// Sort orders the queries by "votes" or "timestamp"
+	Sort string
+
+	// Order is "asc" or "desc"
+	Order string
+}
+
+// QueryTag represents a tag used in the query directory
+type QueryTag struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// savedQueryResults represents a page of query directory results
+type savedQueryResults struct {
+	Matches []SavedQuery `json:"matches"`
+	Total   int64        `json:"total"`
+}
+
+// ListSavedQueries retrieves saved queries from the query directory. Pages
+// are fetched in turn until every query has been retrieved or maxPages have
+// been fetched. The total number of queries is returned alongside them.
+func (c *ShodanClient) ListSavedQueries(listing SavedQueryListing, maxPages int) ([]SavedQuery, int64, error) {
+	endpoint := "/shodan/query"
+	params := url.Values{}
+	params.Set("key", c.ApiKey)
+	if listing.Search != "" || listing.Tag != "" {
+		terms := []string{}
+		if listing.Search != "" {
+			terms = append(terms, listing.Search)
+		}
+		if listing.Tag != "" {
+			terms = append(terms, formatQueryFilter("tag", listing.Tag))
+		}
+
+		endpoint = "/shodan/query/search"
+		params.Set("query", strings.Join(terms, " "))
+	} else {
+		if listing.Sort != "" {
+			params.Set("sort", listing.Sort)
+		}
+		if listing.Order != "" {
+			params.Set("order", listing.Order)
+		}
+	}
+
+	var queries []SavedQuery
+	var total int64
+	for page := 1; page <= maxPages; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s%s?%s", c.BaseURL, endpoint, params.Encode()), nil)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		var results savedQueryResults
+		if err := c.doJSON(req, &results); err != nil {
+			return nil, 0, err
+		}
+
+		queries = append(queries, results.Matches...)
+		total = results.Total
+
+		if len(results.Matches) == 0 || int64(len(queries)) >= total {
+			break
+		}
+	}
+
+	return queries, total, nil
+}
+
+// ListQueryTags retrieves the most popular tags in the query directory, most
+// used first
+func (c *ShodanClient) ListQueryTags(size int) ([]QueryTag, error) {
+	params := url.Values{}
+	params.Set("key", c.ApiKey)
+	params.Set("size", strconv.Itoa(size))
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/shodan/query/tags?%s", c.BaseURL, params.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var results struct {
+		Matches []QueryTag `json:"matches"`
+	}
+	if err := c.doJSON(req, &results); err != nil {
+		return nil, err
+	}
+
+	return results.Matches, nil
+}
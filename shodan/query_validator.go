@@ -0,0 +1,129 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.ConfigValidator = queryValidator{}
+	_ resource.ConfigValidator   = queryValidator{}
+	_ ephemeral.ConfigValidator  = queryValidator{}
+)
+
+// queryValidator checks a search query against Shodan's query parser,
+// reporting syntax errors and unknown filters at plan time. Schemas are
+// cached without a client, so the check is returned from ConfigValidators,
+// which Terraform calls after Configure; it is skipped when the provider has
+// not been configured, such as during terraform validate.
+type queryValidator struct {
+	client *ShodanClient
+	path   path.Path
+}
+
+// newQueryValidator returns a config validator for the query at p. Attributes
+// it covers should also use validators.Query for the checks made offline.
+func newQueryValidator(client *ShodanClient, p path.Path) queryValidator {
+	return queryValidator{client: client, path: p}
+}
+
+func (v queryValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("%s must be a query Shodan can parse, using only known search filters", v.path)
+}
+
+func (v queryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v queryValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	v.validate(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (v queryValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	v.validate(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (v queryValidator) ValidateEphemeralResource(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	v.validate(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (v queryValidator) validate(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	if v.client == nil {
+		return
+	}
+
+	var query types.String
+	diags.Append(config.GetAttribute(ctx, v.path, &query)...)
+	if diags.HasError() || query.IsNull() || query.IsUnknown() {
+		return
+	}
+
+	// Queries failing the offline checks are already reported by validators.Query
+	if validators.ValidateQuery(query.ValueString()) != nil {
+		return
+	}
+
+	tokens, err := v.client.ParseQuery(query.ValueString())
+	if err != nil {
+		// The check is advisory, so a failure to reach Shodan does not block the plan
+		tflog.Warn(ctx, fmt.Sprintf("Could not parse Shodan query %q: %s", query.ValueString(), err.Error()))
+		return
+	}
+
+	breakdown := describeQueryTokens(tokens)
+	tflog.Debug(ctx, fmt.Sprintf("Parsed Shodan query %q. %s", query.ValueString(), breakdown))
+
+	for _, message := range tokens.Errors {
+		diags.AddAttributeError(
+			v.path,
+			"Invalid Shodan query",
+			fmt.Sprintf("Shodan could not parse %q: %s\n\n%s", query.ValueString(), message, breakdown),
+		)
+	}
+
+	filters, err := v.client.ListSearchFilters()
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Could not read the search filters to check query %q: %s", query.ValueString(), err.Error()))
+		return
+	}
+
+	for _, filter := range tokens.Filters {
+		if !slices.Contains(filters, strings.TrimPrefix(filter, "-")) {
+			diags.AddAttributeError(
+				v.path,
+				"Unknown search filter",
+				fmt.Sprintf("%q is not a Shodan search filter. The shodan_search_filters data source lists the filters available.\n\n%s", filter, breakdown),
+			)
+		}
+	}
+}
+
+// describeQueryTokens summarises how Shodan parsed a query, for diagnostics
+func describeQueryTokens(tokens *QueryTokens) string {
+	var b strings.Builder
+	b.WriteString("Shodan parsed the query as:")
+	fmt.Fprintf(&b, "\n  text: %q", tokens.String)
+	if len(tokens.Filters) > 0 {
+		fmt.Fprintf(&b, "\n  filters: %s", strings.Join(tokens.Filters, ", "))
+	}
+
+	attributes := tokens.AttributeValues()
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		fmt.Fprintf(&b, "\n  %s: %s", name, strings.Join(attributes[name], ", "))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,54 @@
+package shodan
+
+import (
+	"slices"
+)
+
+// ListPorts retrieves the ports Shodan's crawlers look for, in ascending
+// order. The list is cached for the life of the provider process.
+func (c *ShodanClient) ListPorts() ([]int64, error) {
+	var ports []int64
+	if err := c.cachedJSON("/shodan/ports", nil, &ports); err != nil {
+		return nil, err
+	}
+
+	slices.Sort(ports)
+	return ports, nil
+}
+
+// ListProtocols retrieves the protocols that on-demand scans can use, mapped
+// to their descriptions. The list is cached for the life of the provider
+// process.
+func (c *ShodanClient) ListProtocols() (map[string]string, error) {
+	var protocols map[string]string
+	if err := c.cachedJSON("/shodan/protocols", nil, &protocols); err != nil {
+		return nil, err
+	}
+
+	return protocols, nil
+}
+
+// ListSearchFilters retrieves the filters that search queries can use, in
+// ascending order. The list is cached for the life of the provider process.
+func (c *ShodanClient) ListSearchFilters() ([]string, error) {
+	var filters []string
+	if err := c.cachedJSON("/shodan/host/search/filters", nil, &filters); err != nil {
+		return nil, err
+	}
+
+	slices.Sort(filters)
+	return filters, nil
+}
+
+// ListSearchFacets retrieves the facets that searches can summarise results
+// by, in ascending order. The list is cached for the life of the provider
+// process.
+func (c *ShodanClient) ListSearchFacets() ([]string, error) {
+	var facets []string
+	if err := c.cachedJSON("/shodan/host/search/facets", nil, &facets); err != nil {
+		return nil, err
+	}
+
+	slices.Sort(facets)
+	return facets, nil
+}
@@ -3,20 +3,34 @@ package shodan
 import (
 	"context"
 	"fmt"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ resource.Resource                = &ShodanAlertResource{}
-	_ resource.ResourceWithConfigure   = &ShodanAlertResource{}
-	_ resource.ResourceWithImportState = &ShodanAlertResource{}
+	_ resource.Resource                 = &ShodanAlertResource{}
+	_ resource.ResourceWithConfigure    = &ShodanAlertResource{}
+	_ resource.ResourceWithImportState  = &ShodanAlertResource{}
+	_ resource.ResourceWithModifyPlan   = &ShodanAlertResource{}
+	_ resource.ResourceWithUpgradeState = &ShodanAlertResource{}
 )
 
 // ShodanAlertResource is the resource implementation.
@@ -28,16 +42,24 @@ type ShodanAlertResource struct {
 type ShodanAlertResourceModel struct {
 	ID                 types.String `tfsdk:"id"`
 	Name               types.String `tfsdk:"name"`
-	Network            types.List   `tfsdk:"network"`
+	Network            types.Set    `tfsdk:"network"`
 	Description        types.String `tfsdk:"description"`
-	Tags               types.List   `tfsdk:"tags"`
+	Tags               types.Set    `tfsdk:"tags"`
 	Enabled            types.Bool   `tfsdk:"enabled"`
-	Triggers           types.List   `tfsdk:"triggers"`
-	Notifiers          types.List   `tfsdk:"notifiers"`
-	SlackNotifications types.List   `tfsdk:"slack_notifications"`
+	Triggers           types.Set    `tfsdk:"triggers"`
+	Notifiers          types.Set    `tfsdk:"notifiers"`
+	SlackNotifications types.Set    `tfsdk:"slack_notifications"`
+	ExpiresInSeconds   types.Int64  `tfsdk:"expires_in_seconds"`
+	Expiration         types.String `tfsdk:"expiration"`
+	ExpiryPolicy       types.String `tfsdk:"expiry_policy"`
+	Filters            types.Object `tfsdk:"filters"`
+	Strict             types.Bool   `tfsdk:"strict"`
 	CreatedAt          types.String `tfsdk:"created_at"`
 }
 
+// alertImportPrefix selects lookup by alert name when importing
+const alertImportPrefix = "name:"
+
 func NewShodanAlertResource() resource.Resource {
 	return &ShodanAlertResource{}
 }
@@ -51,49 +73,161 @@ func (r *ShodanAlertResource) Metadata(_ context.Context, req resource.MetadataR
 func (r *ShodanAlertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Shodan network alert for monitoring specific IP ranges.",
+		Version:     2,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier for the Shodan alert.",
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the Shodan alert.",
+				Description: "The name of the Shodan alert. Shodan alerts can't be renamed, so changing this forces a new alert.",
 				Required:    true,
+				Validators: []validator.String{
+					validators.AlertName(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"network": schema.ListAttribute{
-				Description: "List of IP network ranges to monitor (e.g., ['192.168.1.0/24', '5.6.7.8/32']).",
+			"network": schema.SetAttribute{
+				Description: "Set of IP network ranges to monitor (e.g., ['192.168.1.0/24', '5.6.7.8/32']). IPv4 and IPv6 prefixes are accepted; a bare address is treated as a single host.",
 				ElementType: types.StringType,
 				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(networkValidator{}),
+					uniqueNetworksValidator{},
+				},
+				PlanModifiers: []planmodifier.Set{
+					networkOverlapWarning{},
+				},
+			},
+			"filters": schema.SingleNestedAttribute{
+				Description: "Additional filters that narrow the alert beyond the monitored networks. Removing a filter, or the whole block, clears it on the alert. Filter keys set on the alert outside Terraform that are not listed here are preserved.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"port": schema.SetAttribute{
+						Description: "Only alert on services running on these ports.",
+						ElementType: types.Int64Type,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.ValueInt64sAre(validators.Port()),
+						},
+					},
+					"product": schema.SetAttribute{
+						Description: "Only alert on services identified as these products.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"tag": schema.SetAttribute{
+						Description: "Only alert on services with these Shodan tags.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"vuln": schema.SetAttribute{
+						Description: "Only alert on services affected by these vulnerabilities (CVE IDs).",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
 			},
 			"description": schema.StringAttribute{
-				Description: "A description of the alert.",
+				Description: "A description of the alert. It is stored in the alert name, so changing this forces a new alert.",
 				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"tags": schema.ListAttribute{
-				Description: "Tags to associate with the alert.",
+			"tags": schema.SetAttribute{
+				Description: "Tags to associate with the alert. They are stored in the alert name, so changing them forces a new alert.",
 				ElementType: types.StringType,
 				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.NotBlank()),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
 			},
 			"enabled": schema.BoolAttribute{
-				Description: "Whether the alert is enabled.",
+				Description: "Whether the alert is enabled. Disabling removes the triggers and notifiers from the alert while keeping them in configuration, and enabling adds them back. Defaults to true.",
 				Optional:    true,
 				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"triggers": schema.SetNestedAttribute{
+				Description: "Trigger rules to configure on the alert. Names are validated against the trigger catalogue at plan time.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the trigger (e.g., 'malware').",
+							Required:    true,
+							Validators: []validator.String{
+								validators.TriggerName(),
+							},
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the trigger is enabled on the alert. Defaults to true.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+						},
+						"ignore": schema.SetAttribute{
+							Description: "Services in the form 'ip:port' that should not fire this trigger. When not set, ignored services are left unmanaged.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(validators.Service()),
+							},
+						},
+					},
+				},
 			},
-			"triggers": schema.ListAttribute{
-				Description: "List of trigger rules to enable for the alert.",
+			"notifiers": schema.SetAttribute{
+				Description: "Set of notifier IDs to associate with the alert.",
 				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.NotifierID()),
+				},
 			},
-			"notifiers": schema.ListAttribute{
-				Description: "List of notifier IDs to associate with the alert.",
+			"slack_notifications": schema.SetAttribute{
+				Description: "Set of Slack notifier IDs to associate with the alert. Use the notifier ID from your Shodan account settings.",
 				ElementType: types.StringType,
 				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.NotifierID()),
+				},
+			},
+			"expires_in_seconds": schema.Int64Attribute{
+				Description: "Number of seconds after creation when the alert expires. Changing this forces a new alert.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp when the alert expires, if it has an expiry.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"slack_notifications": schema.ListAttribute{
-				Description: "List of Slack notifier IDs to associate with the alert. Use the notifier ID from your Shodan account settings.",
-				ElementType: types.StringType,
+			"expiry_policy": schema.StringAttribute{
+				Description: "What to do once the alert has expired: 'recreate' removes it from state and plans a new alert, 'ignore' keeps it in state until Shodan removes it. Defaults to 'recreate'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(expiryPolicyRecreate),
+				Validators: []validator.String{
+					stringvalidator.OneOf(expiryPolicies...),
+				},
+			},
+			"strict": schema.BoolAttribute{
+				Description: "Fail the apply when a trigger or notifier cannot be attached, recording only what succeeded in state. Defaults to the provider 'strict' setting.",
 				Optional:    true,
 			},
 			"created_at": schema.StringAttribute{
@@ -105,12 +239,17 @@ func (r *ShodanAlertResource) Schema(_ context.Context, _ resource.SchemaRequest
 }
 
 // Configure adds the provider configured client to the resource.
-func (r *ShodanAlertResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (r *ShodanAlertResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	r.client = req.ProviderData.(*ShodanClient)
+	client := req.ProviderData.(*ShodanClient)
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	r.client = client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -127,11 +266,19 @@ func (r *ShodanAlertResource) Create(ctx context.Context, req resource.CreateReq
 	var networks []string
 	plan.Network.ElementsAs(ctx, &networks, false)
 
-	filters := map[string]interface{}{
-		"ip": networks,
+	filters, diags := buildAlertFilters(ctx, canonicalNetworks(networks), plan.Filters, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	alert, err := r.client.CreateAlert(plan.Name.ValueString(), filters)
+	// Description and tags are stored in the alert name
+	meta := alertMetadata(ctx, plan.Description, plan.Tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := r.client.CreateAlert(AlertNameWithMetadata(plan.Name.ValueString(), meta), filters, plan.ExpiresInSeconds.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Shodan alert",
@@ -140,49 +287,27 @@ func (r *ShodanAlertResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	// Add triggers if specified
-	if !plan.Triggers.IsNull() {
-		var triggers []types.String
-		plan.Triggers.ElementsAs(ctx, &triggers, false)
-		for _, trigger := range triggers {
-			if err := r.client.AddTrigger(alert.ID, trigger.ValueString()); err != nil {
-				tflog.Warn(ctx, fmt.Sprintf("Failed to add trigger %s: %s", trigger.ValueString(), err.Error()))
-			}
-		}
-	}
+	// Roll back the alert if the rest of the create fails
+	tx := &createTransaction{client: r.client}
+	tx.created(alert.ID)
 
-	// Add notifiers if specified
-	if !plan.Notifiers.IsNull() {
-		var notifiers []types.String
-		plan.Notifiers.ElementsAs(ctx, &notifiers, false)
-		for _, notifier := range notifiers {
-			if err := r.client.AddNotifier(alert.ID, notifier.ValueString()); err != nil {
-				tflog.Warn(ctx, fmt.Sprintf("Failed to add notifier %s: %s", notifier.ValueString(), err.Error()))
-			}
-		}
+	// Add triggers and notifiers, unless the alert starts out disabled
+	if plan.Enabled.ValueBool() {
+		r.attachToAlert(ctx, alert.ID, nil, &plan, true, &resp.Diagnostics)
 	}
 
-	// Add Slack notifications if specified
-	if !plan.SlackNotifications.IsNull() {
-		var slackChannels []types.String
-		plan.SlackNotifications.ElementsAs(ctx, &slackChannels, false)
-		for _, channel := range slackChannels {
-			if err := r.client.AddSlackNotifier(alert.ID, channel.ValueString()); err != nil {
-				tflog.Warn(ctx, fmt.Sprintf("Failed to add Slack notification for channel %s: %s", channel.ValueString(), err.Error()))
-			}
-		}
+	// Notifiers are computed when not configured
+	if plan.Notifiers.IsUnknown() {
+		plan.Notifiers = types.SetNull(types.StringType)
 	}
 
 	// Set computed values
 	plan.ID = types.StringValue(alert.ID)
 	plan.CreatedAt = types.StringValue(alert.Created)
-	if plan.Enabled.IsNull() {
-		plan.Enabled = types.BoolValue(true)
-	}
+	plan.Expiration = expirationValue(alert)
 
-	// Set state
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
+	// Set state, or roll back the alert if anything failed
+	tx.commit(ctx, plan, resp)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -197,6 +322,9 @@ func (r *ShodanAlertResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Get the alert from Shodan API
 	alert, err := r.client.GetAlert(state.ID.ValueString())
+	if removeDeletedAlert(ctx, state.ID.ValueString(), err, resp) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Shodan alert",
@@ -205,28 +333,58 @@ func (r *ShodanAlertResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	if removeExpiredAlert(ctx, alert, state.ExpiryPolicy, resp) {
+		return
+	}
+
 	// Update state with latest values
-	state.Name = types.StringValue(alert.Name)
+	name, meta := ParseAlertName(alert.Name)
+	state.Name = types.StringValue(name)
+	state.Description = metadataDescription(meta, state.Description)
+	state.Tags = metadataTags(meta, state.Tags)
 	state.CreatedAt = types.StringValue(alert.Created)
-	state.Enabled = types.BoolValue(alert.HasTriggers)
-
-	// Extract networks from filters
-	if alert.Filters != nil {
-		if ipFilters, ok := alert.Filters["ip"]; ok {
-			if ipList, ok := ipFilters.([]interface{}); ok {
-				var networks []attr.Value
-				for _, ip := range ipList {
-					if ipStr, ok := ip.(string); ok {
-						networks = append(networks, types.StringValue(ipStr))
-					}
-				}
-				if len(networks) > 0 {
-					state.Network = types.ListValueMust(types.StringType, networks)
-				}
-			}
+	state.Expiration = expirationValue(alert)
+
+	// A disabled alert has nothing attached, so the desired triggers in state
+	// are kept. Triggers enabled outside Terraform mean the alert is enabled.
+	enabled := alert.HasTriggers || state.Enabled.IsNull() || state.Enabled.ValueBool()
+	state.Enabled = types.BoolValue(enabled)
+	if enabled {
+		triggers, diags := triggersFromAlert(ctx, alert, state.Triggers)
+		resp.Diagnostics.Append(diags...)
+		state.Triggers = triggers
+
+		// Older API responses may not list notifiers, in which case state is kept
+		if alert.Notifiers != nil {
+			notifiers, slackNotifiers := splitNotifiers(
+				alert,
+				setStrings(ctx, state.Notifiers, &resp.Diagnostics),
+				setStrings(ctx, state.SlackNotifications, &resp.Diagnostics),
+			)
+			state.Notifiers = stringSetValue(notifiers)
+			state.SlackNotifications = stringSetValue(slackNotifiers)
 		}
 	}
 
+	// Settings that can't be read back from Shodan are missing after import
+	if state.ExpiresInSeconds.IsNull() && alert.Expires > 0 {
+		state.ExpiresInSeconds = types.Int64Value(int64(alert.Expires))
+	}
+	if state.ExpiryPolicy.IsNull() {
+		state.ExpiryPolicy = types.StringValue(expiryPolicyRecreate)
+	}
+
+	filters, diags := filtersFromAlert(alert, state.Filters)
+	resp.Diagnostics.Append(diags...)
+	state.Filters = filters
+
+	// Extract networks from filters, keeping the configured form of each one
+	if networks := alertNetworks(alert); len(networks) > 0 {
+		var prior []string
+		resp.Diagnostics.Append(state.Network.ElementsAs(ctx, &prior, false)...)
+		state.Network = stringSetValue(preserveNetworks(networks, prior))
+	}
+
 	// Set state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -249,15 +407,11 @@ func (r *ShodanAlertResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	// Update network filters if changed
-	if !plan.Network.Equal(state.Network) {
+	// Update network and other filters if changed
+	if !plan.Network.Equal(state.Network) || !plan.Filters.Equal(state.Filters) {
 		var networks []string
 		plan.Network.ElementsAs(ctx, &networks, false)
 
-		filters := map[string]interface{}{
-			"ip": networks,
-		}
-
 		// Use state.ID instead of plan.ID since plan.ID might be empty during updates
 		alertID := state.ID.ValueString()
 		if alertID == "" {
@@ -268,6 +422,22 @@ func (r *ShodanAlertResource) Update(ctx context.Context, req resource.UpdateReq
 			return
 		}
 
+		// Start from the filters on the alert so keys the provider doesn't manage are kept
+		current, err := r.client.GetAlert(alertID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Shodan alert",
+				fmt.Sprintf("Could not read alert %s before updating its filters, unexpected error: %s", alertID, err.Error()),
+			)
+			return
+		}
+
+		filters, diags := buildAlertFilters(ctx, canonicalNetworks(networks), plan.Filters, current.Filters)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		if err := r.client.UpdateAlert(alertID, filters); err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating Shodan alert network",
@@ -277,49 +447,22 @@ func (r *ShodanAlertResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
-	// Update triggers if changed
-	if !plan.Triggers.Equal(state.Triggers) {
-		// Remove old triggers and add new ones
-		// Note: Shodan API doesn't support removing triggers, so we'll just add new ones
-		if !plan.Triggers.IsNull() {
-			var triggers []types.String
-			plan.Triggers.ElementsAs(ctx, &triggers, false)
-			for _, trigger := range triggers {
-				if err := r.client.AddTrigger(state.ID.ValueString(), trigger.ValueString()); err != nil {
-					tflog.Warn(ctx, fmt.Sprintf("Failed to add trigger %s: %s", trigger.ValueString(), err.Error()))
-				}
-			}
-		}
+	alertID := state.ID.ValueString()
+
+	// Disabling removes everything from the alert and enabling attaches it all again
+	wasEnabled := state.Enabled.IsNull() || state.Enabled.ValueBool()
+	switch {
+	case plan.Enabled.ValueBool() && wasEnabled:
+		r.attachToAlert(ctx, alertID, &state, &plan, false, &resp.Diagnostics)
+	case plan.Enabled.ValueBool():
+		r.attachToAlert(ctx, alertID, nil, &plan, false, &resp.Diagnostics)
+	case wasEnabled:
+		r.detachFromAlert(ctx, alertID, &state, &plan, &resp.Diagnostics)
 	}
 
-	// Update notifiers if changed
-	if !plan.Notifiers.Equal(state.Notifiers) {
-		// Remove old notifiers and add new ones
-		// Note: Shodan API doesn't support removing notifiers, so we'll just add new ones
-		if !plan.Notifiers.IsNull() {
-			var notifiers []types.String
-			plan.Notifiers.ElementsAs(ctx, &notifiers, false)
-			for _, notifier := range notifiers {
-				if err := r.client.AddNotifier(state.ID.ValueString(), notifier.ValueString()); err != nil {
-					tflog.Warn(ctx, fmt.Sprintf("Failed to add notifier %s: %s", notifier.ValueString(), err.Error()))
-				}
-			}
-		}
-	}
-
-	// Update Slack notifications if changed
-	if !plan.SlackNotifications.Equal(state.SlackNotifications) {
-		// Remove old Slack notifications and add new ones
-		// Note: Shodan API doesn't support removing notifiers, so we'll just add new ones
-		if !plan.SlackNotifications.IsNull() {
-			var slackChannels []types.String
-			plan.SlackNotifications.ElementsAs(ctx, &slackChannels, false)
-			for _, channel := range slackChannels {
-				if err := r.client.AddSlackNotifier(state.ID.ValueString(), channel.ValueString()); err != nil {
-					tflog.Warn(ctx, fmt.Sprintf("Failed to add Slack notification for channel %s: %s", channel.ValueString(), err.Error()))
-				}
-			}
-		}
+	// Notifiers are computed when not configured
+	if plan.Notifiers.IsUnknown() {
+		plan.Notifiers = state.Notifiers
 	}
 
 	// After all updates, read the current state from the API to ensure computed fields are set correctly
@@ -335,23 +478,13 @@ func (r *ShodanAlertResource) Update(ctx context.Context, req resource.UpdateReq
 	// Update the plan with the latest values from the API
 	plan.ID = types.StringValue(updatedAlert.ID)
 	plan.CreatedAt = types.StringValue(updatedAlert.Created)
-	plan.Enabled = types.BoolValue(updatedAlert.HasTriggers)
-
-	// Extract networks from filters if available
-	if updatedAlert.Filters != nil {
-		if ipFilters, ok := updatedAlert.Filters["ip"]; ok {
-			if ipList, ok := ipFilters.([]interface{}); ok {
-				var networks []attr.Value
-				for _, ip := range ipList {
-					if ipStr, ok := ip.(string); ok {
-						networks = append(networks, types.StringValue(ipStr))
-					}
-				}
-				if len(networks) > 0 {
-					plan.Network = types.ListValueMust(types.StringType, networks)
-				}
-			}
-		}
+	plan.Expiration = expirationValue(updatedAlert)
+
+	// Extract networks from filters, keeping the configured form of each one
+	if networks := alertNetworks(updatedAlert); len(networks) > 0 {
+		var planned []string
+		resp.Diagnostics.Append(plan.Network.ElementsAs(ctx, &planned, false)...)
+		plan.Network = stringSetValue(preserveNetworks(networks, planned))
 	}
 
 	// Set state with updated values
@@ -380,8 +513,230 @@ func (r *ShodanAlertResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
-// ImportState imports an existing resource into Terraform state.
+// attachToAlert moves the triggers and notifiers on the alert from those in
+// prior to those in plan, where prior is nil when nothing is attached. In
+// strict mode plan is updated to record only what was actually attached.
+func (r *ShodanAlertResource) attachToAlert(ctx context.Context, alertID string, prior, plan *ShodanAlertResourceModel, creating bool, diags *diag.Diagnostics) {
+	var current map[string][]string
+	priorNotifiers := types.SetNull(types.StringType)
+	priorSlackNotifiers := types.SetNull(types.StringType)
+	if prior != nil {
+		var d diag.Diagnostics
+		current, d = enabledTriggers(ctx, prior.Triggers)
+		diags.Append(d...)
+		priorNotifiers = prior.Notifiers
+		priorSlackNotifiers = prior.SlackNotifications
+	}
+
+	// Enable triggers and their ignored services
+	desired, d := enabledTriggers(ctx, plan.Triggers)
+	diags.Append(d...)
+	triggerErrs := syncTriggers(r.client, alertID, current, desired)
+
+	// Remove notifiers that are no longer configured and add those that
+	// aren't attached yet
+	notifierRemoveErrs := removeNotifiers(r.client, alertID, "notifier", removedStrings(ctx, priorNotifiers, plan.Notifiers, diags))
+	notifiers, added := addedStrings(ctx, priorNotifiers, plan.Notifiers, diags)
+	notifierErrs := addNotifiers(r.client, alertID, "notifier", added)
+
+	// Same for Slack notifications
+	slackRemoveErrs := removeNotifiers(r.client, alertID, "Slack notifier", removedStrings(ctx, priorSlackNotifiers, plan.SlackNotifications, diags))
+	slackNotifiers, addedSlack := addedStrings(ctx, priorSlackNotifiers, plan.SlackNotifications, diags)
+	slackErrs := addNotifiers(r.client, alertID, "Slack notifier", addedSlack)
+
+	failures := append(triggerErrs, attachErrors(notifierRemoveErrs, notifierErrs, slackRemoveErrs, slackErrs)...)
+	strict := isStrict(plan.Strict, r.client)
+	reportAttachFailures(diags, alertID, strict, failures, creating)
+
+	// In strict mode only record what was actually attached. A failed create
+	// is rolled back by the caller, so the alert isn't read again.
+	if strict && len(failures) > 0 {
+		if creating {
+			return
+		}
+		if len(triggerErrs) > 0 {
+			diags.Append(r.readTriggers(ctx, alertID, plan)...)
+		}
+		// Notifiers that could not be removed are still attached
+		if len(notifierErrs) > 0 || len(notifierRemoveErrs) > 0 {
+			plan.Notifiers = stringSetValue(append(withoutFailed(notifiers, notifierErrs), failedIDs(notifierRemoveErrs)...))
+		}
+		if len(slackErrs) > 0 || len(slackRemoveErrs) > 0 {
+			plan.SlackNotifications = stringSetValue(append(withoutFailed(slackNotifiers, slackErrs), failedIDs(slackRemoveErrs)...))
+		}
+	}
+}
+
+// detachFromAlert removes the triggers and notifiers in prior from the alert.
+// plan keeps the desired ones so enabling the alert restores them. In strict
+// mode a failure keeps the alert recorded as enabled with what is still attached.
+func (r *ShodanAlertResource) detachFromAlert(ctx context.Context, alertID string, prior, plan *ShodanAlertResourceModel, diags *diag.Diagnostics) {
+	current, d := enabledTriggers(ctx, prior.Triggers)
+	diags.Append(d...)
+	triggerErrs := syncTriggers(r.client, alertID, current, nil)
+
+	notifierErrs := removeNotifiers(r.client, alertID, "notifier", setStrings(ctx, prior.Notifiers, diags))
+	slackErrs := removeNotifiers(r.client, alertID, "Slack notifier", setStrings(ctx, prior.SlackNotifications, diags))
+
+	failures := append(triggerErrs, attachErrors(notifierErrs, slackErrs)...)
+	strict := isStrict(plan.Strict, r.client)
+	reportDetachFailures(diags, alertID, strict, failures)
+
+	if strict && len(failures) > 0 {
+		plan.Enabled = types.BoolValue(true)
+		diags.Append(r.readTriggers(ctx, alertID, plan)...)
+		plan.Notifiers = stringSetValue(failedIDs(notifierErrs))
+		plan.SlackNotifications = stringSetValue(failedIDs(slackErrs))
+	}
+}
+
+// readTriggers replaces the triggers in the model with those actually
+// configured on the alert in Shodan.
+func (r *ShodanAlertResource) readTriggers(ctx context.Context, alertID string, model *ShodanAlertResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	alert, err := r.client.GetAlert(alertID)
+	if err != nil {
+		diags.AddError(
+			"Error reading Shodan alert",
+			fmt.Sprintf("Could not read alert %s to record its triggers, unexpected error: %s", alertID, err.Error()),
+		)
+		return diags
+	}
+
+	triggers, d := triggersFromAlert(ctx, alert, model.Triggers)
+	diags.Append(d...)
+	model.Triggers = triggers
+
+	return diags
+}
+
+// ModifyPlan validates the length of the generated alert name and trigger names
+// against the live trigger catalogue, and warns when the monitored networks
+// overlap those of other alerts.
+func (r *ShodanAlertResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ShodanAlertResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.IsUnknown() && metadataKnown(plan.Description, plan.Tags) {
+		meta := alertMetadata(ctx, plan.Description, plan.Tags, &resp.Diagnostics)
+		resp.Diagnostics.Append(validateAlertNameLength(AlertNameWithMetadata(plan.Name.ValueString(), meta), path.Root("name"))...)
+	}
+
+	r.warnNetworkOverlaps(ctx, plan, resp)
+
+	if plan.Triggers.IsNull() || plan.Triggers.IsUnknown() {
+		return
+	}
+
+	// ElementsAs keeps the order of Elements, so each model lines up with its set element
+	elements := plan.Triggers.Elements()
+	var triggers []AlertTriggerModel
+	resp.Diagnostics.Append(plan.Triggers.ElementsAs(ctx, &triggers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := map[string]path.Path{}
+	for i, trigger := range triggers {
+		if trigger.Name.IsUnknown() {
+			continue
+		}
+		names[trigger.Name.ValueString()] = path.Root("triggers").AtSetValue(elements[i]).AtName("name")
+	}
+
+	resp.Diagnostics.Append(validateTriggerNames(r.client, names)...)
+}
+
+// warnNetworkOverlaps warns when the planned networks overlap networks monitored
+// by other alerts on the account. The check is advisory, so failing to list the
+// alerts is only logged.
+func (r *ShodanAlertResource) warnNetworkOverlaps(ctx context.Context, plan ShodanAlertResourceModel, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || plan.Network.IsNull() || plan.Network.IsUnknown() {
+		return
+	}
+
+	var networks []types.String
+	resp.Diagnostics.Append(plan.Network.ElementsAs(ctx, &networks, false)...)
+
+	var known []string
+	for _, network := range networks {
+		if !network.IsUnknown() {
+			known = append(known, network.ValueString())
+		}
+	}
+	if len(known) == 0 {
+		return
+	}
+
+	alerts, err := r.client.ListAlertsCached()
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Could not list alerts to check for overlapping networks: %s", err.Error()))
+		return
+	}
+
+	for i := range alerts {
+		alert := &alerts[i]
+		if alert.ID == plan.ID.ValueString() {
+			continue
+		}
+
+		if overlaps := networkOverlaps(known, alertNetworks(alert)); len(overlaps) > 0 {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("network"),
+				"Networks overlap another alert",
+				fmt.Sprintf("Alert %q (%s) already monitors part of these networks, so matching IPs are counted more than once against the monitored IP quota: %s.", alert.Name, alert.ID, strings.Join(overlaps, "; ")),
+			)
+		}
+	}
+}
+
+// ImportState imports an existing resource into Terraform state. Read then
+// fills in every other attribute from the alert.
 func (r *ShodanAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by alert ID
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	name, byName := strings.CutPrefix(req.ID, alertImportPrefix)
+	if !byName {
+		// Import by alert ID
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+		return
+	}
+
+	// Import by name, ignoring the metadata suffix that holds description and tags
+	matches, err := r.client.FindAlertsByName(func(alertName string) bool {
+		alertName, _ = ParseAlertName(alertName)
+		return alertName == name
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing Shodan alert",
+			fmt.Sprintf("Could not list alerts to find %q: %s", name, err.Error()),
+		)
+		return
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Shodan alert not found",
+			fmt.Sprintf("No alert named %q was found.", name),
+		)
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError(
+			"Multiple Shodan alerts found",
+			fmt.Sprintf("Found %d alerts named %q (%s). Import one of them by ID instead.", len(matches), name, strings.Join(alertIDs(matches), ", ")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), matches[0].ID)...)
 }
@@ -0,0 +1,79 @@
+package shodan
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShodanAlertModifyPlanUnknownTrigger(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeShodan{handler: func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name":"malware"},{"name":"open_database"}]`))
+	}}
+	r := &ShodanAlertResource{client: newTestClient(t, fake)}
+
+	unknown := triggerValue("malwar", true, nil)
+	state := resourceState(t, r, map[string]string{"name": "web"})
+	triggers := types.SetValueMust(alertTriggerObjectType, []attr.Value{triggerValue("malware", true, nil), unknown})
+	if diags := state.SetAttribute(ctx, path.Root("triggers"), triggers); diags.HasError() {
+		t.Fatalf("could not set triggers: %v", diags)
+	}
+
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 {
+		t.Fatalf("got %d errors %v, want 1", len(errs), errs)
+	}
+
+	want := path.Root("triggers").AtSetValue(unknown).AtName("name")
+	got, ok := errs[0].(diag.DiagnosticWithPath)
+	if !ok || !got.Path().Equal(want) {
+		t.Errorf("got error %v, want it at %s", errs[0], want)
+	}
+}
+
+func TestShodanAlertModifyPlanNameLength(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		tags        types.Set
+		wantErr     bool
+	}{
+		{name: "short", description: "Production network", tags: stringSet("prod")},
+		{name: "long description", description: strings.Repeat("d", 230), tags: stringSet("prod"), wantErr: true},
+		{name: "long tags", tags: stringSet(strings.Repeat("a", 120), strings.Repeat("b", 120)), wantErr: true},
+		{name: "unknown tags", description: strings.Repeat("d", 300), tags: types.SetUnknown(types.StringType)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &ShodanAlertResource{}
+
+			state := resourceState(t, r, map[string]string{"name": "web", "description": tt.description})
+			if diags := state.SetAttribute(ctx, path.Root("tags"), tt.tags); diags.HasError() {
+				t.Fatalf("could not set tags: %v", diags)
+			}
+
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("got diagnostics %v, want error %t", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &ShodanAlertTriggerIgnoreResource{}
+	_ resource.ResourceWithConfigure   = &ShodanAlertTriggerIgnoreResource{}
+	_ resource.ResourceWithImportState = &ShodanAlertTriggerIgnoreResource{}
+)
+
+// ShodanAlertTriggerIgnoreResource is the resource implementation.
+type ShodanAlertTriggerIgnoreResource struct {
+	client *ShodanClient
+}
+
+// ShodanAlertTriggerIgnoreResourceModel describes the resource data model.
+type ShodanAlertTriggerIgnoreResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	AlertID types.String `tfsdk:"alert_id"`
+	Trigger types.String `tfsdk:"trigger"`
+	IP      types.String `tfsdk:"ip"`
+	Port    types.Int64  `tfsdk:"port"`
+}
+
+func NewShodanAlertTriggerIgnoreResource() resource.Resource {
+	return &ShodanAlertTriggerIgnoreResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *ShodanAlertTriggerIgnoreResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_trigger_ignore"
+}
+
+// Schema defines the schema for the resource.
+func (r *ShodanAlertTriggerIgnoreResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Mutes a Shodan alert trigger for a specific service (ip:port), e.g. a known and accepted open port.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the ignore entry in the form '{alert_id}/{trigger}/{ip}:{port}'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alert_id": schema.StringAttribute{
+				Description: "The ID of the alert the trigger belongs to.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NotBlank(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trigger": schema.StringAttribute{
+				Description: "The name of the trigger to mute (e.g., 'new_service').",
+				Required:    true,
+				Validators: []validator.String{
+					validators.TriggerName(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Description: "The IP address of the service to ignore.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "The port of the service to ignore.",
+				Required:    true,
+				Validators: []validator.Int64{
+					validators.Port(),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ShodanAlertTriggerIgnoreResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ShodanClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ShodanClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ShodanAlertTriggerIgnoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ShodanAlertTriggerIgnoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := plan.service()
+	if err := r.client.AddTriggerIgnore(plan.AlertID.ValueString(), plan.Trigger.ValueString(), service); err != nil {
+		resp.Diagnostics.AddError(
+			"Error ignoring service for Shodan alert trigger",
+			fmt.Sprintf("Could not ignore %s for trigger %s on alert %s: %s", service, plan.Trigger.ValueString(), plan.AlertID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", plan.AlertID.ValueString(), plan.Trigger.ValueString(), service))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ShodanAlertTriggerIgnoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ShodanAlertTriggerIgnoreResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := r.client.GetAlert(state.AlertID.ValueString())
+	if removeDeletedAlert(ctx, state.AlertID.ValueString(), err, resp) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Shodan alert",
+			fmt.Sprintf("Could not read alert %s, unexpected error: %s", state.AlertID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// The entry is gone if the trigger was removed or the service is no longer whitelisted
+	services, ok := alert.IgnoredServices(state.Trigger.ValueString())
+	if !ok || !slices.Contains(services, state.service()) {
+		tflog.Info(ctx, fmt.Sprintf("Ignore entry %s no longer exists, removing it from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Every argument forces replacement, so there is nothing to send to Shodan.
+func (r *ShodanAlertTriggerIgnoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ShodanAlertTriggerIgnoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ShodanAlertTriggerIgnoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ShodanAlertTriggerIgnoreResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.RemoveTriggerIgnore(state.AlertID.ValueString(), state.Trigger.ValueString(), state.service()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error removing ignored service from Shodan alert trigger",
+			fmt.Sprintf("Could not remove %s from trigger %s on alert %s: %s", state.service(), state.Trigger.ValueString(), state.AlertID.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing ignore entry using '{alert_id}/{trigger}/{ip}:{port}'.
+func (r *ShodanAlertTriggerIgnoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the form '{alert_id}/{trigger}/{ip}:{port}', got: %q", req.ID),
+		)
+		return
+	}
+
+	sep := strings.LastIndex(parts[2], ":")
+	if sep < 0 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected the service to be in the form '{ip}:{port}', got: %q", parts[2]),
+		)
+		return
+	}
+
+	port, err := strconv.ParseInt(parts[2][sep+1:], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Could not parse port in %q: %s", parts[2], err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alert_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("trigger"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), parts[2][:sep])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port"), port)...)
+}
+
+// service returns the ignored service in the ip:port form used by the Shodan API
+func (m ShodanAlertTriggerIgnoreResourceModel) service() string {
+	return fmt.Sprintf("%s:%d", m.IP.ValueString(), m.Port.ValueInt64())
+}
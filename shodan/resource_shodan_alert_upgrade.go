@@ -0,0 +1,253 @@
+package shodan
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// shodanAlertResourceModelV0 describes the resource data model before triggers
+// became structured objects.
+type shodanAlertResourceModelV0 struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Network            types.List   `tfsdk:"network"`
+	Description        types.String `tfsdk:"description"`
+	Tags               types.List   `tfsdk:"tags"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Triggers           types.List   `tfsdk:"triggers"`
+	Notifiers          types.List   `tfsdk:"notifiers"`
+	SlackNotifications types.List   `tfsdk:"slack_notifications"`
+	ExpiresInSeconds   types.Int64  `tfsdk:"expires_in_seconds"`
+	Expiration         types.String `tfsdk:"expiration"`
+	ExpiryPolicy       types.String `tfsdk:"expiry_policy"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+}
+
+// shodanAlertSchemaV0 is the version 0 schema, used to decode prior state.
+func shodanAlertSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                  schema.StringAttribute{Computed: true},
+			"name":                schema.StringAttribute{Required: true},
+			"network":             schema.ListAttribute{ElementType: types.StringType, Required: true},
+			"description":         schema.StringAttribute{Optional: true},
+			"tags":                schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"enabled":             schema.BoolAttribute{Optional: true, Computed: true},
+			"triggers":            schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"notifiers":           schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"slack_notifications": schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"expires_in_seconds":  schema.Int64Attribute{Optional: true},
+			"expiration":          schema.StringAttribute{Computed: true},
+			"expiry_policy":       schema.StringAttribute{Optional: true, Computed: true},
+			"created_at":          schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+// shodanAlertResourceModelV1 describes the resource data model before network,
+// tags and notifiers became sets.
+type shodanAlertResourceModelV1 struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Network            types.List   `tfsdk:"network"`
+	Description        types.String `tfsdk:"description"`
+	Tags               types.List   `tfsdk:"tags"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Triggers           types.Set    `tfsdk:"triggers"`
+	Notifiers          types.List   `tfsdk:"notifiers"`
+	SlackNotifications types.List   `tfsdk:"slack_notifications"`
+	ExpiresInSeconds   types.Int64  `tfsdk:"expires_in_seconds"`
+	Expiration         types.String `tfsdk:"expiration"`
+	ExpiryPolicy       types.String `tfsdk:"expiry_policy"`
+	Filters            types.Object `tfsdk:"filters"`
+	Strict             types.Bool   `tfsdk:"strict"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+}
+
+// shodanAlertSchemaV1 is the version 1 schema, used to decode prior state.
+func shodanAlertSchemaV1() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"name":    schema.StringAttribute{Required: true},
+			"network": schema.ListAttribute{ElementType: types.StringType, Required: true},
+			"filters": schema.SingleNestedAttribute{
+				Optional: true,
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"port":    schema.SetAttribute{ElementType: types.Int64Type, Optional: true},
+					"product": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"tag":     schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"vuln":    schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				},
+			},
+			"description": schema.StringAttribute{Optional: true},
+			"tags":        schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"enabled":     schema.BoolAttribute{Optional: true, Computed: true},
+			"triggers": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":    schema.StringAttribute{Required: true},
+						"enabled": schema.BoolAttribute{Optional: true, Computed: true},
+						"ignore":  schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					},
+				},
+			},
+			"notifiers":           schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"slack_notifications": schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"expires_in_seconds":  schema.Int64Attribute{Optional: true},
+			"expiration":          schema.StringAttribute{Computed: true},
+			"expiry_policy":       schema.StringAttribute{Optional: true, Computed: true},
+			"strict":              schema.BoolAttribute{Optional: true},
+			"created_at":          schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+// upgradeAlertStateV1 converts version 1 state to the current model
+func upgradeAlertStateV1(ctx context.Context, prior shodanAlertResourceModelV1) (ShodanAlertResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Version 1 stored these as lists, so duplicates are dropped
+	network, d := stringListToSet(ctx, prior.Network)
+	diags.Append(d...)
+	tags, d := stringListToSet(ctx, prior.Tags)
+	diags.Append(d...)
+	notifiers, d := stringListToSet(ctx, prior.Notifiers)
+	diags.Append(d...)
+	slackNotifications, d := stringListToSet(ctx, prior.SlackNotifications)
+	diags.Append(d...)
+
+	// filters was added without a version bump, so it may be missing
+	if prior.Filters.IsNull() {
+		prior.Filters = types.ObjectNull(alertFiltersAttrTypes)
+	}
+
+	return ShodanAlertResourceModel{
+		ID:                 prior.ID,
+		Name:               prior.Name,
+		Network:            network,
+		Description:        prior.Description,
+		Tags:               tags,
+		Enabled:            prior.Enabled,
+		Triggers:           prior.Triggers,
+		Filters:            prior.Filters,
+		Notifiers:          notifiers,
+		SlackNotifications: slackNotifications,
+		ExpiresInSeconds:   prior.ExpiresInSeconds,
+		Expiration:         prior.Expiration,
+		ExpiryPolicy:       prior.ExpiryPolicy,
+		Strict:             prior.Strict,
+		CreatedAt:          prior.CreatedAt,
+	}, diags
+}
+
+// stringListToSet converts a list of strings into a set, dropping duplicates
+func stringListToSet(ctx context.Context, list types.List) (types.Set, diag.Diagnostics) {
+	if list.IsNull() {
+		return types.SetNull(types.StringType), nil
+	}
+	if list.IsUnknown() {
+		return types.SetUnknown(types.StringType), nil
+	}
+
+	var values []types.String
+	diags := list.ElementsAs(ctx, &values, false)
+
+	var unique []attr.Value
+	for _, value := range uniqueValues(values) {
+		unique = append(unique, value)
+	}
+
+	set, d := types.SetValue(types.StringType, unique)
+	diags.Append(d...)
+	return set, diags
+}
+
+// UpgradeState migrates prior versions of the resource state.
+func (r *ShodanAlertResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: shodanAlertSchemaV0(),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior shodanAlertResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Version 0 stored triggers as a flat list of enabled trigger names
+				triggers := types.SetNull(alertTriggerObjectType)
+				if !prior.Triggers.IsNull() && !prior.Triggers.IsUnknown() {
+					var names []string
+					resp.Diagnostics.Append(prior.Triggers.ElementsAs(ctx, &names, false)...)
+
+					elements := make([]attr.Value, 0, len(names))
+					for _, name := range names {
+						elements = append(elements, types.ObjectValueMust(alertTriggerAttrTypes, map[string]attr.Value{
+							"name":    types.StringValue(name),
+							"enabled": types.BoolValue(true),
+							"ignore":  types.SetNull(types.StringType),
+						}))
+					}
+					if len(elements) > 0 {
+						triggers = types.SetValueMust(alertTriggerObjectType, elements)
+					}
+				}
+
+				// expiry_policy was added without a version bump, so it may be missing
+				if prior.ExpiryPolicy.IsNull() {
+					prior.ExpiryPolicy = types.StringValue(expiryPolicyRecreate)
+				}
+
+				upgraded, diags := upgradeAlertStateV1(ctx, shodanAlertResourceModelV1{
+					ID:                 prior.ID,
+					Name:               prior.Name,
+					Network:            prior.Network,
+					Description:        prior.Description,
+					Tags:               prior.Tags,
+					Enabled:            prior.Enabled,
+					Triggers:           triggers,
+					Filters:            types.ObjectNull(alertFiltersAttrTypes),
+					Notifiers:          prior.Notifiers,
+					SlackNotifications: prior.SlackNotifications,
+					ExpiresInSeconds:   prior.ExpiresInSeconds,
+					Expiration:         prior.Expiration,
+					ExpiryPolicy:       prior.ExpiryPolicy,
+					Strict:             types.BoolNull(),
+					CreatedAt:          prior.CreatedAt,
+				})
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+		1: {
+			PriorSchema: shodanAlertSchemaV1(),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior shodanAlertResourceModelV1
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded, diags := upgradeAlertStateV1(ctx, prior)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,211 @@
+package shodan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// stringList builds a list of strings, or a null list if values is nil
+func stringList(values ...string) types.List {
+	if values == nil {
+		return types.ListNull(types.StringType)
+	}
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elements[i] = types.StringValue(value)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// stringSet builds a set of strings, or a null set if values is nil
+func stringSet(values ...string) types.Set {
+	if values == nil {
+		return types.SetNull(types.StringType)
+	}
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elements[i] = types.StringValue(value)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// upgradeState runs a resource's state upgrader for version on prior, which
+// is stored with the upgrader's prior schema
+func upgradeState(t *testing.T, r resource.ResourceWithUpgradeState, version int64, prior interface{}) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	if !ok {
+		t.Fatalf("no state upgrader for version %d", version)
+	}
+
+	priorState := tfsdk.State{
+		Schema: *upgrader.PriorSchema,
+		Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+	}
+	if diags := priorState.Set(ctx, prior); diags.HasError() {
+		t.Fatalf("could not set prior state: %v", diags)
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &priorState}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("state upgrade failed: %v", resp.Diagnostics)
+	}
+
+	return resp.State
+}
+
+func TestShodanAlertUpgradeStateV0(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior shodanAlertResourceModelV0
+		want  ShodanAlertResourceModel
+	}{
+		{
+			name: "flat triggers and lists with duplicates",
+			prior: shodanAlertResourceModelV0{
+				ID:                 types.StringValue("A1"),
+				Name:               types.StringValue("web"),
+				Network:            stringList("198.51.100.0/24", "203.0.113.7", "198.51.100.0/24"),
+				Tags:               stringList("prod", "prod"),
+				Enabled:            types.BoolValue(true),
+				Triggers:           stringList("malware", "open_database"),
+				Notifiers:          stringList("default"),
+				SlackNotifications: stringList(),
+				ExpiryPolicy:       types.StringValue("ignore"),
+			},
+			want: ShodanAlertResourceModel{
+				ID:      types.StringValue("A1"),
+				Name:    types.StringValue("web"),
+				Network: stringSet("198.51.100.0/24", "203.0.113.7"),
+				Tags:    stringSet("prod"),
+				Enabled: types.BoolValue(true),
+				Triggers: types.SetValueMust(alertTriggerObjectType, []attr.Value{
+					triggerValue("malware", true, nil),
+					triggerValue("open_database", true, nil),
+				}),
+				Notifiers:          stringSet("default"),
+				SlackNotifications: stringSet(),
+				ExpiryPolicy:       types.StringValue("ignore"),
+				Filters:            types.ObjectNull(alertFiltersAttrTypes),
+			},
+		},
+		{
+			name: "missing triggers and expiry policy",
+			prior: shodanAlertResourceModelV0{
+				ID:                 types.StringValue("A1"),
+				Name:               types.StringValue("web"),
+				Network:            stringList("198.51.100.0/24"),
+				Tags:               stringList(),
+				Triggers:           stringList(),
+				Notifiers:          stringList(),
+				SlackNotifications: stringList(),
+			},
+			want: ShodanAlertResourceModel{
+				ID:                 types.StringValue("A1"),
+				Name:               types.StringValue("web"),
+				Network:            stringSet("198.51.100.0/24"),
+				Tags:               stringSet(),
+				Triggers:           types.SetNull(alertTriggerObjectType),
+				Notifiers:          stringSet(),
+				SlackNotifications: stringSet(),
+				ExpiryPolicy:       types.StringValue(expiryPolicyRecreate),
+				Filters:            types.ObjectNull(alertFiltersAttrTypes),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := upgradeState(t, &ShodanAlertResource{}, 0, tt.prior)
+
+			var got ShodanAlertResourceModel
+			if diags := state.Get(context.Background(), &got); diags.HasError() {
+				t.Fatalf("could not read upgraded state: %v", diags)
+			}
+			assertAlertModel(t, got, tt.want)
+		})
+	}
+}
+
+func TestShodanAlertUpgradeStateV1(t *testing.T) {
+	prior := shodanAlertResourceModelV1{
+		ID:      types.StringValue("A1"),
+		Name:    types.StringValue("web"),
+		Network: stringList("2001:db8::/32", "2001:db8::/32"),
+		Tags:    stringList(),
+		Enabled: types.BoolValue(false),
+		Triggers: types.SetValueMust(alertTriggerObjectType, []attr.Value{
+			triggerValue("malware", false, []string{"198.51.100.1:80"}),
+		}),
+		Notifiers:          stringList("default", "N1", "default"),
+		SlackNotifications: stringList(),
+		ExpiryPolicy:       types.StringValue("ignore"),
+		Filters:            types.ObjectNull(alertFiltersAttrTypes),
+		Strict:             types.BoolValue(true),
+	}
+
+	state := upgradeState(t, &ShodanAlertResource{}, 1, prior)
+
+	var got ShodanAlertResourceModel
+	if diags := state.Get(context.Background(), &got); diags.HasError() {
+		t.Fatalf("could not read upgraded state: %v", diags)
+	}
+
+	assertAlertModel(t, got, ShodanAlertResourceModel{
+		ID:                 types.StringValue("A1"),
+		Name:               types.StringValue("web"),
+		Network:            stringSet("2001:db8::/32"),
+		Tags:               stringSet(),
+		Enabled:            types.BoolValue(false),
+		Triggers:           prior.Triggers,
+		Notifiers:          stringSet("default", "N1"),
+		SlackNotifications: stringSet(),
+		ExpiryPolicy:       types.StringValue("ignore"),
+		Strict:             types.BoolValue(true),
+		Filters:            types.ObjectNull(alertFiltersAttrTypes),
+	})
+}
+
+// assertAlertModel compares the attributes carried over by a state upgrade
+func assertAlertModel(t *testing.T, got, want ShodanAlertResourceModel) {
+	t.Helper()
+
+	checks := []struct {
+		name      string
+		got, want attr.Value
+	}{
+		{"id", got.ID, want.ID},
+		{"name", got.Name, want.Name},
+		{"network", got.Network, want.Network},
+		{"tags", got.Tags, want.Tags},
+		{"enabled", got.Enabled, want.Enabled},
+		{"triggers", got.Triggers, want.Triggers},
+		{"notifiers", got.Notifiers, want.Notifiers},
+		{"slack_notifications", got.SlackNotifications, want.SlackNotifications},
+		{"expiry_policy", got.ExpiryPolicy, want.ExpiryPolicy},
+		{"filters", got.Filters, want.Filters},
+		{"strict", got.Strict, want.Strict},
+	}
+	for _, check := range checks {
+		if !check.got.Equal(check.want) {
+			t.Errorf("%s = %s, want %s", check.name, check.got, check.want)
+		}
+	}
+}
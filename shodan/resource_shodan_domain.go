@@ -3,18 +3,33 @@ package shodan
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/AdconnectDevOps/terraform-provider-shodan/shodan/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ resource.Resource                = &ShodanDomainResource{}
-	_ resource.ResourceWithConfigure   = &ShodanDomainResource{}
-	_ resource.ResourceWithImportState = &ShodanDomainResource{}
+	_ resource.Resource                 = &ShodanDomainResource{}
+	_ resource.ResourceWithConfigure    = &ShodanDomainResource{}
+	_ resource.ResourceWithImportState  = &ShodanDomainResource{}
+	_ resource.ResourceWithModifyPlan   = &ShodanDomainResource{}
+	_ resource.ResourceWithUpgradeState = &ShodanDomainResource{}
 )
 
 // ShodanDomainResource is the resource implementation.
@@ -32,9 +47,17 @@ type ShodanDomainResourceModel struct {
 	Triggers           []types.String `tfsdk:"triggers"`
 	Notifiers          []types.String `tfsdk:"notifiers"`
 	SlackNotifications []types.String `tfsdk:"slack_notifications"`
+	AdoptExisting      types.Bool     `tfsdk:"adopt_existing"`
+	ExpiresInSeconds   types.Int64    `tfsdk:"expires_in_seconds"`
+	Expiration         types.String   `tfsdk:"expiration"`
+	ExpiryPolicy       types.String   `tfsdk:"expiry_policy"`
+	Strict             types.Bool     `tfsdk:"strict"`
 	CreatedAt          types.String   `tfsdk:"created_at"`
 }
 
+// domainImportPrefix selects lookup by domain name when importing
+const domainImportPrefix = "domain:"
+
 func NewShodanDomainResource() resource.Resource {
 	return &ShodanDomainResource{}
 }
@@ -46,6 +69,7 @@ func (r *ShodanDomainResource) Metadata(ctx context.Context, req resource.Metada
 func (r *ShodanDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Monitor a domain for security threats using Shodan alerts.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier for the Shodan domain alert.",
@@ -54,34 +78,90 @@ func (r *ShodanDomainResource) Schema(ctx context.Context, req resource.SchemaRe
 			"domain": schema.StringAttribute{
 				Description: "The domain name to monitor (e.g., 'example.com').",
 				Required:    true,
+				Validators: []validator.String{
+					validators.Domain(),
+				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Optional custom name for the alert. If not provided, will use '__domain: {domain}' format.",
+				Description: "Optional custom name for the alert. If not provided, will use '__domain: {domain}' format. Changing this forces a new alert.",
 				Optional:    true,
+				Validators: []validator.String{
+					validators.AlertName(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"description": schema.StringAttribute{
-				Description: "Optional description of the domain monitoring alert.",
+				Description: "Optional description of the domain monitoring alert. It is stored in the alert name, so changing this forces a new alert.",
 				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"enabled": schema.BoolAttribute{
-				Description: "Whether the domain monitoring alert is enabled. Defaults to true.",
+				Description: "Whether the domain monitoring alert is enabled. Disabling removes the triggers and notifiers from the alert while keeping them in configuration, and enabling adds them back. Defaults to true.",
 				Optional:    true,
 				Computed:    true,
+				Default:     booldefault.StaticBool(true),
 			},
-			"triggers": schema.ListAttribute{
-				Description: "List of trigger rules to enable for domain monitoring.",
+			"triggers": schema.SetAttribute{
+				Description: "Set of trigger rules to enable for domain monitoring.",
 				ElementType: types.StringType,
 				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.TriggerName()),
+				},
 			},
-			"notifiers": schema.ListAttribute{
-				Description: "List of notifier IDs to associate with the domain alert.",
+			"notifiers": schema.SetAttribute{
+				Description: "Set of notifier IDs to associate with the domain alert.",
 				ElementType: types.StringType,
 				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.NotifierID()),
+				},
 			},
-			"slack_notifications": schema.ListAttribute{
-				Description: "List of Slack notification IDs to associate with the domain alert.",
+			"slack_notifications": schema.SetAttribute{
+				Description: "Set of Slack notification IDs to associate with the domain alert.",
 				ElementType: types.StringType,
 				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.NotifierID()),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "Take ownership of an existing alert with the generated '__domain: {domain}' name instead of creating a duplicate.",
+				Optional:    true,
+			},
+			"expires_in_seconds": schema.Int64Attribute{
+				Description: "Number of seconds after creation when the domain alert expires. Changing this forces a new alert.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp when the domain alert expires, if it has an expiry.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiry_policy": schema.StringAttribute{
+				Description: "What to do once the domain alert has expired: 'recreate' removes it from state and plans a new alert, 'ignore' keeps it in state until Shodan removes it. Defaults to 'recreate'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(expiryPolicyRecreate),
+				Validators: []validator.String{
+					stringvalidator.OneOf(expiryPolicies...),
+				},
+			},
+			"strict": schema.BoolAttribute{
+				Description: "Fail the apply when a trigger or notifier cannot be attached, recording only what succeeded in state. Defaults to the provider 'strict' setting.",
+				Optional:    true,
 			},
 			"created_at": schema.StringAttribute{
 				Description: "The timestamp when the domain alert was created.",
@@ -108,6 +188,10 @@ func (r *ShodanDomainResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
+	if missingAPIKey(client, &resp.Diagnostics) {
+		return
+	}
+
 	r.client = client
 }
 
@@ -121,74 +205,79 @@ func (r *ShodanDomainResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	// Set default values
-	if data.Enabled.IsNull() {
-		data.Enabled = types.BoolValue(true)
-	}
-
-	// Convert triggers to string slice
-	var triggers []string
-	if len(data.Triggers) > 0 {
-		for _, trigger := range data.Triggers {
-			triggers = append(triggers, trigger.ValueString())
+	// Roll back a newly created alert if the rest of the create fails
+	tx := &createTransaction{client: r.client}
+
+	// Look for an alert created by an earlier run before creating a new one
+	var alertResp *AlertResponse
+	var adoptedPrior *ShodanDomainResourceModel
+	if data.AdoptExisting.ValueBool() {
+		alertName := DomainAlertName(data.Domain.ValueString(), data.Name.ValueString())
+		matches, err := r.client.FindAlertsByName(func(name string) bool {
+			name, _ = ParseAlertName(name)
+			return name == alertName
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error looking up existing domain alert",
+				fmt.Sprintf("Could not list alerts to adopt %q: %s", alertName, err.Error()),
+			)
+			return
 		}
-	}
 
-	// Create domain alert without triggers first
-	alertResp, err := r.client.CreateDomainAlert(data.Name.ValueString(), data.Domain.ValueString(), nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating domain alert",
-			fmt.Sprintf("Could not create domain alert for %s: %s", data.Domain.ValueString(), err.Error()),
-		)
-		return
-	}
-
-	// Set the ID and created timestamp
-	data.ID = types.StringValue(alertResp.ID)
-	data.CreatedAt = types.StringValue(alertResp.Created)
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple existing domain alerts found",
+				fmt.Sprintf("Found %d alerts named %q (%s). Delete the duplicates or import one of them by ID.", len(matches), alertName, strings.Join(alertIDs(matches), ", ")),
+			)
+			return
+		}
 
-	// Add triggers if specified
-	if len(triggers) > 0 {
-		for _, trigger := range triggers {
-			err := r.client.AddTrigger(alertResp.ID, trigger)
-			if err != nil {
-				resp.Diagnostics.AddWarning(
-					"Warning adding trigger",
-					fmt.Sprintf("Could not add trigger %s: %s", trigger, err.Error()),
-				)
+		if len(matches) == 1 {
+			tflog.Info(ctx, fmt.Sprintf("Adopting existing alert %s named %q", matches[0].ID, alertName))
+			alertResp = &matches[0]
+			tx.adopted(alertResp.ID)
+
+			// Start from what is already attached, so anything not configured is removed
+			notifiers, slackNotifiers := splitNotifiers(alertResp, valueStrings(data.Notifiers), valueStrings(data.SlackNotifications))
+			adoptedPrior = &ShodanDomainResourceModel{
+				Triggers:           setElements(sortedKeys(alertResp.Triggers)),
+				Notifiers:          setElements(notifiers),
+				SlackNotifications: setElements(slackNotifiers),
 			}
 		}
 	}
 
-	// Add notifiers if specified (after triggers are set)
-	if len(data.Notifiers) > 0 {
-		for _, notifier := range data.Notifiers {
-			err := r.client.AddNotifier(alertResp.ID, notifier.ValueString())
-			if err != nil {
-				resp.Diagnostics.AddWarning(
-					"Warning adding notifier",
-					fmt.Sprintf("Could not add notifier %s: %s", notifier.ValueString(), err.Error()),
-				)
-			}
+	// Create domain alert without triggers first
+	if alertResp == nil {
+		var err error
+		alertResp, err = r.client.CreateDomainAlert(data.Name.ValueString(), data.Domain.ValueString(), AlertMetadata{Description: data.Description.ValueString()}, nil, data.ExpiresInSeconds.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating domain alert",
+				fmt.Sprintf("Could not create domain alert for %s: %s", data.Domain.ValueString(), err.Error()),
+			)
+			return
 		}
+		tx.created(alertResp.ID)
 	}
 
-	// Add Slack notifications if specified (after triggers are set)
-	if len(data.SlackNotifications) > 0 {
-		for _, slackNotifier := range data.SlackNotifications {
-			err := r.client.AddNotifier(alertResp.ID, slackNotifier.ValueString())
-			if err != nil {
-				resp.Diagnostics.AddWarning(
-					"Warning adding Slack notifier",
-					fmt.Sprintf("Could not add Slack notifier %s: %s", slackNotifier.ValueString(), err.Error()),
-				)
-			}
-		}
+	// Set the ID and created timestamp
+	data.ID = types.StringValue(alertResp.ID)
+	data.CreatedAt = types.StringValue(alertResp.Created)
+	data.Expiration = expirationValue(alertResp)
+
+	// Add triggers and notifiers (after the alert exists), unless it starts
+	// out disabled, in which case an adopted alert has its own detached
+	switch {
+	case data.Enabled.ValueBool():
+		r.attachToAlert(alertResp.ID, adoptedPrior, &data, &resp.Diagnostics)
+	case adoptedPrior != nil:
+		r.detachFromAlert(alertResp.ID, adoptedPrior, &data, &resp.Diagnostics)
 	}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// Save data into Terraform state, or roll back the alert if anything failed
+	tx.commit(ctx, &data, resp)
 }
 
 func (r *ShodanDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -203,6 +292,9 @@ func (r *ShodanDomainResource) Read(ctx context.Context, req resource.ReadReques
 
 	// Get the alert information
 	alert, err := r.client.GetAlert(data.ID.ValueString())
+	if removeDeletedAlert(ctx, data.ID.ValueString(), err, resp) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading domain alert",
@@ -211,8 +303,45 @@ func (r *ShodanDomainResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	// Update the model with the current state
+	if removeExpiredAlert(ctx, alert, data.ExpiryPolicy, resp) {
+		return
+	}
+
+	// Update the model with the current state. Triggers enabled outside
+	// Terraform mean a disabled alert is enabled again.
+	enabled := alert.HasTriggers || data.Enabled.IsNull() || data.Enabled.ValueBool()
+	data.Enabled = types.BoolValue(enabled)
+
+	// A disabled alert has nothing attached, so the desired triggers and
+	// notifiers in state are kept
+	if enabled {
+		data.Triggers = setElements(sortedKeys(alert.Triggers))
+
+		// Older API responses may not list notifiers, in which case state is kept
+		if alert.Notifiers != nil {
+			notifiers, slackNotifiers := splitNotifiers(alert, valueStrings(data.Notifiers), valueStrings(data.SlackNotifications))
+			data.Notifiers = setElements(notifiers)
+			data.SlackNotifications = setElements(slackNotifiers)
+		}
+	}
+	_, meta := ParseAlertName(alert.Name)
+	data.Description = metadataDescription(meta, data.Description)
 	data.CreatedAt = types.StringValue(alert.Created)
+	data.Expiration = expirationValue(alert)
+
+	// Domain and name are recovered from the alert name, e.g. after import
+	if domain, name, ok := ParseDomainAlertName(alert.Name); ok {
+		data.Domain = types.StringValue(domain)
+		data.Name = optionalString(name)
+	}
+
+	// Settings that can't be read back from Shodan are missing after import
+	if data.ExpiresInSeconds.IsNull() && alert.Expires > 0 {
+		data.ExpiresInSeconds = types.Int64Value(int64(alert.Expires))
+	}
+	if data.ExpiryPolicy.IsNull() {
+		data.ExpiryPolicy = types.StringValue(expiryPolicyRecreate)
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -248,7 +377,7 @@ func (r *ShodanDomainResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 
 		// Create new alert
-		alertResp, err := r.client.CreateDomainAlert(data.Name.ValueString(), data.Domain.ValueString(), nil)
+		alertResp, err := r.client.CreateDomainAlert(data.Name.ValueString(), data.Domain.ValueString(), AlertMetadata{Description: data.Description.ValueString()}, nil, data.ExpiresInSeconds.ValueInt64())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating new domain alert",
@@ -259,18 +388,23 @@ func (r *ShodanDomainResource) Update(ctx context.Context, req resource.UpdateRe
 
 		data.ID = types.StringValue(alertResp.ID)
 		data.CreatedAt = types.StringValue(alertResp.Created)
+		data.Expiration = expirationValue(alertResp)
 
-		// Add triggers if specified
-		if len(data.Triggers) > 0 {
-			for _, trigger := range data.Triggers {
-				err := r.client.AddTrigger(alertResp.ID, trigger.ValueString())
-				if err != nil {
-					resp.Diagnostics.AddWarning(
-						"Warning adding trigger",
-						fmt.Sprintf("Could not add trigger %s: %s", trigger.ValueString(), err.Error()),
-					)
-				}
-			}
+		// Add triggers and notifiers to the new alert
+		if data.Enabled.ValueBool() {
+			r.attachToAlert(alertResp.ID, nil, &data, &resp.Diagnostics)
+		}
+	} else {
+		// Disabling removes everything from the alert and enabling attaches it all again
+		wasEnabled := oldData.Enabled.IsNull() || oldData.Enabled.ValueBool()
+		switch {
+		case data.Enabled.ValueBool() && wasEnabled:
+			// Add and remove triggers and notifiers to match the plan
+			r.attachToAlert(oldData.ID.ValueString(), &oldData, &data, &resp.Diagnostics)
+		case data.Enabled.ValueBool():
+			r.attachToAlert(oldData.ID.ValueString(), &ShodanDomainResourceModel{}, &data, &resp.Diagnostics)
+		case wasEnabled:
+			r.detachFromAlert(oldData.ID.ValueString(), &oldData, &data, &resp.Diagnostics)
 		}
 	}
 
@@ -299,7 +433,153 @@ func (r *ShodanDomainResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+// attachToAlert moves the triggers and notifiers on the alert from those in
+// prior to those in data, where prior is nil for a new alert. In strict mode
+// failures are errors and data is updated to record what is actually attached.
+func (r *ShodanDomainResource) attachToAlert(alertID string, prior *ShodanDomainResourceModel, data *ShodanDomainResourceModel, diags *diag.Diagnostics) {
+	var before ShodanDomainResourceModel
+	if prior != nil {
+		before = *prior
+	}
+
+	// Detach what is no longer configured, then attach what is new
+	triggerRemoveErrs := removeTriggers(r.client, alertID, stringsNotIn(before.Triggers, data.Triggers))
+	notifierRemoveErrs := removeNotifiers(r.client, alertID, "notifier", stringsNotIn(before.Notifiers, data.Notifiers))
+	slackRemoveErrs := removeNotifiers(r.client, alertID, "Slack notifier", stringsNotIn(before.SlackNotifications, data.SlackNotifications))
+
+	triggerErrs := addTriggers(r.client, alertID, stringsNotIn(data.Triggers, before.Triggers))
+	notifierErrs := addNotifiers(r.client, alertID, "notifier", stringsNotIn(data.Notifiers, before.Notifiers))
+	slackErrs := addNotifiers(r.client, alertID, "Slack notifier", stringsNotIn(data.SlackNotifications, before.SlackNotifications))
+
+	failures := attachErrors(triggerRemoveErrs, notifierRemoveErrs, slackRemoveErrs, triggerErrs, notifierErrs, slackErrs)
+	strict := isStrict(data.Strict, r.client)
+	reportAttachFailures(diags, alertID, strict, failures, prior == nil)
+
+	// In strict mode only record what was actually attached, including
+	// anything that could not be detached
+	if strict && len(failures) > 0 {
+		data.Triggers = append(withoutFailedValues(data.Triggers, triggerErrs), failedValues(triggerRemoveErrs)...)
+		data.Notifiers = append(withoutFailedValues(data.Notifiers, notifierErrs), failedValues(notifierRemoveErrs)...)
+		data.SlackNotifications = append(withoutFailedValues(data.SlackNotifications, slackErrs), failedValues(slackRemoveErrs)...)
+	}
+}
+
+// detachFromAlert removes the triggers and notifiers in prior from the alert.
+// data keeps the desired ones so enabling the alert restores them. In strict
+// mode a failure keeps the alert recorded as enabled with what is still attached.
+func (r *ShodanDomainResource) detachFromAlert(alertID string, prior *ShodanDomainResourceModel, data *ShodanDomainResourceModel, diags *diag.Diagnostics) {
+	triggerErrs := removeTriggers(r.client, alertID, valueStrings(prior.Triggers))
+	notifierErrs := removeNotifiers(r.client, alertID, "notifier", valueStrings(prior.Notifiers))
+	slackErrs := removeNotifiers(r.client, alertID, "Slack notifier", valueStrings(prior.SlackNotifications))
+
+	failures := attachErrors(triggerErrs, notifierErrs, slackErrs)
+	strict := isStrict(data.Strict, r.client)
+	reportDetachFailures(diags, alertID, strict, failures)
+
+	if strict && len(failures) > 0 {
+		data.Enabled = types.BoolValue(true)
+		data.Triggers = failedValues(triggerErrs)
+		data.Notifiers = failedValues(notifierErrs)
+		data.SlackNotifications = failedValues(slackErrs)
+	}
+}
+
+// ModifyPlan validates the length of the generated alert name and trigger
+// names against the live trigger catalogue.
+func (r *ShodanDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data ShodanDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Domain.IsUnknown() && !data.Name.IsUnknown() && !data.Description.IsUnknown() {
+		alertName := AlertNameWithMetadata(DomainAlertName(data.Domain.ValueString(), data.Name.ValueString()), AlertMetadata{Description: data.Description.ValueString()})
+		resp.Diagnostics.Append(validateAlertNameLength(alertName, path.Root("domain"))...)
+	}
+
+	names := map[string]path.Path{}
+	for _, trigger := range data.Triggers {
+		if trigger.IsUnknown() || trigger.IsNull() {
+			continue
+		}
+		names[trigger.ValueString()] = path.Root("triggers").AtSetValue(trigger)
+	}
+
+	resp.Diagnostics.Append(validateTriggerNames(r.client, names)...)
+}
+
 func (r *ShodanDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by alert ID
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	var alert *AlertResponse
+	if domain, byDomain := strings.CutPrefix(req.ID, domainImportPrefix); byDomain {
+		// Import by domain, using the same naming convention as CreateDomainAlert
+		matches, err := r.client.FindAlertsByName(func(name string) bool {
+			alertDomain, _, ok := ParseDomainAlertName(name)
+			return ok && alertDomain == domain
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing domain alert",
+				fmt.Sprintf("Could not list alerts to find domain %s: %s", domain, err.Error()),
+			)
+			return
+		}
+
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddError(
+				"Domain alert not found",
+				fmt.Sprintf("No alert exists for domain %s.", domain),
+			)
+			return
+		case 1:
+		default:
+			resp.Diagnostics.AddError(
+				"Multiple domain alerts found",
+				fmt.Sprintf("Found %d alerts for domain %s (%s). Import one of them by ID instead.", len(matches), domain, strings.Join(alertIDs(matches), ", ")),
+			)
+			return
+		}
+
+		alert = &matches[0]
+	} else {
+		// Import by alert ID
+		var err error
+		alert, err = r.client.GetAlert(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing domain alert",
+				fmt.Sprintf("Could not read alert %s: %s", req.ID, err.Error()),
+			)
+			return
+		}
+	}
+
+	// The domain can only be recovered from alerts that follow the naming convention
+	domain, name, ok := ParseDomainAlertName(alert.Name)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Alert is not a domain alert",
+			fmt.Sprintf("Alert %s is named %q, which doesn't follow the %q naming convention used for domain alerts, so its domain can't be determined. Import it as a shodan_alert resource instead.", alert.ID, alert.Name, DomainAlertName("<domain>", "")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), alert.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), optionalString(name))...)
+}
+
+// alertIDs returns the IDs of the given alerts
+func alertIDs(alerts []AlertResponse) []string {
+	ids := make([]string, len(alerts))
+	for i, alert := range alerts {
+		ids[i] = alert.ID
+	}
+	return ids
 }
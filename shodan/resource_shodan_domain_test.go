@@ -0,0 +1,115 @@
+package shodan
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestShodanDomainCreateAdoptExisting(t *testing.T) {
+	tests := []struct {
+		name         string
+		enabled      bool
+		wantRequests []string
+	}{
+		{
+			name:    "enabled",
+			enabled: true,
+			wantRequests: []string{
+				"DELETE /shodan/alert/A1/notifier/N2",
+				"DELETE /shodan/alert/A1/trigger/iot",
+				"GET /shodan/alert/info",
+				"PUT /shodan/alert/A1/notifier/N3",
+				"PUT /shodan/alert/A1/trigger/open_database",
+			},
+		},
+		{
+			name: "disabled",
+			wantRequests: []string{
+				"DELETE /shodan/alert/A1/notifier/N1",
+				"DELETE /shodan/alert/A1/notifier/N2",
+				"DELETE /shodan/alert/A1/trigger/iot",
+				"DELETE /shodan/alert/A1/trigger/malware",
+				"GET /shodan/alert/info",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			fake := &fakeShodan{handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					w.Write([]byte(`[{"id":"A1","name":"__domain: example.com","triggers":{"malware":{},"iot":{}},"notifiers":[{"id":"N1","provider":"email"},{"id":"N2","provider":"email"}]}]`))
+					return
+				}
+				w.Write([]byte(`{"success":true}`))
+			}}
+			r := &ShodanDomainResource{client: newTestClient(t, fake)}
+
+			state := resourceState(t, r, map[string]string{"domain": "example.com"})
+			values := map[string]interface{}{
+				"enabled":        tt.enabled,
+				"adopt_existing": true,
+				"triggers":       []string{"malware", "open_database"},
+				"notifiers":      []string{"N1", "N3"},
+			}
+			for name, value := range values {
+				if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+					t.Fatalf("could not set %s: %v", name, diags)
+				}
+			}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}}
+			r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			slices.Sort(fake.requests)
+			if !slices.Equal(fake.requests, tt.wantRequests) {
+				t.Errorf("got requests %q, want %q", fake.requests, tt.wantRequests)
+			}
+
+			var data ShodanDomainResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.ID.ValueString() != "A1" {
+				t.Errorf("got ID %s, want A1", data.ID)
+			}
+		})
+	}
+}
+
+func TestShodanDomainModifyPlanNameLength(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   map[string]string
+		wantErr bool
+	}{
+		{name: "short", attrs: map[string]string{"domain": "example.com", "name": "marketing", "description": "Marketing site"}},
+		{name: "long description", attrs: map[string]string{"domain": "example.com", "description": strings.Repeat("d", 230)}, wantErr: true},
+		{name: "long domain and name", attrs: map[string]string{"domain": strings.Repeat("a", 63) + ".example.com", "name": strings.Repeat("n", 180)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &ShodanDomainResource{}
+
+			state := resourceState(t, r, tt.attrs)
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("got diagnostics %v, want error %t", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package shodan
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// shodanDomainSchemaV0 is the version 0 schema, used to decode prior state.
+// It only differs from the current schema in storing triggers and notifiers as
+// lists, so prior state decodes into the current model.
+func shodanDomainSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                  schema.StringAttribute{Computed: true},
+			"domain":              schema.StringAttribute{Required: true},
+			"name":                schema.StringAttribute{Optional: true},
+			"description":         schema.StringAttribute{Optional: true},
+			"enabled":             schema.BoolAttribute{Optional: true, Computed: true},
+			"triggers":            schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"notifiers":           schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"slack_notifications": schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"adopt_existing":      schema.BoolAttribute{Optional: true},
+			"expires_in_seconds":  schema.Int64Attribute{Optional: true},
+			"expiration":          schema.StringAttribute{Computed: true},
+			"expiry_policy":       schema.StringAttribute{Optional: true, Computed: true},
+			"strict":              schema.BoolAttribute{Optional: true},
+			"created_at":          schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+// UpgradeState migrates prior versions of the resource state.
+func (r *ShodanDomainResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: shodanDomainSchemaV0(),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var data ShodanDomainResourceModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Version 0 stored these as lists, so duplicates are dropped
+				data.Triggers = uniqueValues(data.Triggers)
+				data.Notifiers = uniqueValues(data.Notifiers)
+				data.SlackNotifications = uniqueValues(data.SlackNotifications)
+
+				// expiry_policy was added without a version bump, so it may be missing
+				if data.ExpiryPolicy.IsNull() {
+					data.ExpiryPolicy = types.StringValue(expiryPolicyRecreate)
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package shodan
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShodanDomainUpgradeStateV0(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior ShodanDomainResourceModel
+		want  ShodanDomainResourceModel
+	}{
+		{
+			name: "lists with duplicates",
+			prior: ShodanDomainResourceModel{
+				ID:                 types.StringValue("A1"),
+				Domain:             types.StringValue("example.com"),
+				Enabled:            types.BoolValue(true),
+				Triggers:           []types.String{types.StringValue("malware"), types.StringValue("open_database"), types.StringValue("malware")},
+				Notifiers:          []types.String{types.StringValue("default"), types.StringValue("default")},
+				SlackNotifications: []types.String{types.StringValue("S1")},
+				ExpiryPolicy:       types.StringValue("ignore"),
+			},
+			want: ShodanDomainResourceModel{
+				ID:                 types.StringValue("A1"),
+				Domain:             types.StringValue("example.com"),
+				Enabled:            types.BoolValue(true),
+				Triggers:           []types.String{types.StringValue("malware"), types.StringValue("open_database")},
+				Notifiers:          []types.String{types.StringValue("default")},
+				SlackNotifications: []types.String{types.StringValue("S1")},
+				ExpiryPolicy:       types.StringValue("ignore"),
+			},
+		},
+		{
+			name: "missing expiry policy",
+			prior: ShodanDomainResourceModel{
+				ID:     types.StringValue("A1"),
+				Domain: types.StringValue("example.com"),
+			},
+			want: ShodanDomainResourceModel{
+				ID:           types.StringValue("A1"),
+				Domain:       types.StringValue("example.com"),
+				ExpiryPolicy: types.StringValue(expiryPolicyRecreate),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := upgradeState(t, &ShodanDomainResource{}, 0, tt.prior)
+
+			var got ShodanDomainResourceModel
+			if diags := state.Get(context.Background(), &got); diags.HasError() {
+				t.Fatalf("could not read upgraded state: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package shodan
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// QueryTokens represents how Shodan parses a search query
+type QueryTokens struct {
+	// String is the free-text part of the query, without filters
+	String string `json:"string"`
+
+	// Filters lists the names of the filters used by the query
+	Filters []string `json:"filters"`
+
+	// Attributes holds the values Shodan parsed out of the filters, keyed by
+	// attribute, such as {"ports": [80, 443]}
+	Attributes map[string]json.RawMessage `json:"attributes"`
+
+	// Errors lists the problems Shodan found parsing the query
+	Errors []string `json:"errors"`
+}
+
+// ParseQuery breaks a search query into the tokens Shodan parses it into,
+// without running the search or consuming query credits. Results are cached
+// per query for the life of the provider process.
+func (c *ShodanClient) ParseQuery(query string) (*QueryTokens, error) {
+	var tokens QueryTokens
+	if err := c.cachedJSON("/shodan/host/search/tokens", url.Values{"query": {query}}, &tokens); err != nil {
+		return nil, err
+	}
+
+	return &tokens, nil
+}
+
+// AttributeValues returns the values Shodan parsed for each attribute as
+// strings. Lists are flattened into their elements and other values are
+// rendered as JSON.
+func (t *QueryTokens) AttributeValues() map[string][]string {
+	result := make(map[string][]string, len(t.Attributes))
+	for name, raw := range t.Attributes {
+		var elements []json.RawMessage
+		if err := json.Unmarshal(raw, &elements); err != nil {
+			elements = []json.RawMessage{raw}
+		}
+
+		values := make([]string, len(elements))
+		for i, element := range elements {
+			var s string
+			if err := json.Unmarshal(element, &s); err == nil {
+				values[i] = s
+			} else {
+				values[i] = string(element)
+			}
+		}
+		result[name] = values
+	}
+
+	return result
+}
+
+// BuildQuery renders filters as a search query, such as
+// `country:US product:"Apache httpd"`. Filters are sorted by name so the same
+// map always produces the same query.
+func BuildQuery(filters map[string]string) (string, error) {
+	names := make([]string, 0, len(filters))
+	for name, value := range filters {
+		if strings.TrimSpace(name) == "" {
+			return "", fmt.Errorf("filter names must not be empty")
+		}
+		if strings.ContainsAny(name, " \t\":") {
+			return "", fmt.Errorf("filter name %q must not contain spaces, quotes or colons", name)
+		}
+		if strings.TrimSpace(value) == "" {
+			return "", fmt.Errorf("filter %q must have a value", name)
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	terms := make([]string, len(names))
+	for i, name := range names {
+		terms[i] = formatQueryFilter(name, filters[name])
+	}
+	return strings.Join(terms, " "), nil
+}
+
+// formatQueryFilter renders a single name:value filter, quoting the value
+// when it contains whitespace or quotes
+func formatQueryFilter(name, value string) string {
+	return fmt.Sprintf("%s:%s", name, quoteQueryValue(value))
+}
+
+// quoteQueryValue wraps a filter value in double quotes if Shodan would
+// otherwise split it, escaping quotes and backslashes inside it. Unlike
+// strconv.Quote, non-ASCII characters are left as they are.
+func quoteQueryValue(value string) string {
+	if !strings.ContainsAny(value, " \t\"") {
+		return value
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range value {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,74 @@
+package shodan
+
+import "testing"
+
+func TestBuildQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters map[string]string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:    "sorted by name",
+			filters: map[string]string{"port": "443", "country": "US"},
+			want:    "country:US port:443",
+		},
+		{
+			name:    "values with spaces are quoted",
+			filters: map[string]string{"product": "Apache httpd"},
+			want:    `product:"Apache httpd"`,
+		},
+		{
+			name:    "quotes and backslashes are escaped",
+			filters: map[string]string{"title": `say "hi" \o/`},
+			want:    `title:"say \"hi\" \\o/"`,
+		},
+		{
+			name:    "values without spaces are left alone",
+			filters: map[string]string{"http.html": `a\b`, "org": "Zürich"},
+			want:    `http.html:a\b org:Zürich`,
+		},
+		{
+			name:    "non-ASCII is not escaped",
+			filters: map[string]string{"city": "São Paulo"},
+			want:    `city:"São Paulo"`,
+		},
+		{
+			name:    "empty name",
+			filters: map[string]string{" ": "US"},
+			wantErr: true,
+		},
+		{
+			name:    "name with a colon",
+			filters: map[string]string{"country:": "US"},
+			wantErr: true,
+		},
+		{
+			name:    "empty value",
+			filters: map[string]string{"country": " "},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildQuery(tt.filters)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("BuildQuery(%q) = %q, want an error", tt.filters, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BuildQuery(%q) returned an error: %s", tt.filters, err)
+			}
+			if got != tt.want {
+				t.Errorf("BuildQuery(%q) = %q, want %q", tt.filters, got, tt.want)
+			}
+		})
+	}
+}
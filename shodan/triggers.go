@@ -0,0 +1,213 @@
+package shodan
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AlertTriggerModel describes a single trigger configured on an alert.
+type AlertTriggerModel struct {
+	Name    types.String `tfsdk:"name"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Ignore  types.Set    `tfsdk:"ignore"`
+}
+
+// alertTriggerAttrTypes are the attribute types of AlertTriggerModel
+var alertTriggerAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"enabled": types.BoolType,
+	"ignore":  types.SetType{ElemType: types.StringType},
+}
+
+// alertTriggerObjectType is the element type of the triggers set
+var alertTriggerObjectType = types.ObjectType{AttrTypes: alertTriggerAttrTypes}
+
+// validateTriggerNames checks trigger names against the live trigger catalogue
+// and reports unknown names at their attribute path. If the catalogue cannot be
+// fetched a warning is added and validation is skipped.
+func validateTriggerNames(client *ShodanClient, names map[string]path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if client == nil || len(names) == 0 {
+		return diags
+	}
+
+	catalogue, err := client.ListTriggers()
+	if err != nil {
+		diags.AddWarning(
+			"Could not validate trigger names",
+			fmt.Sprintf("Could not fetch the trigger catalogue from Shodan, trigger names were not validated: %s", err.Error()),
+		)
+		return diags
+	}
+
+	known := make(map[string]bool, len(catalogue))
+	available := make([]string, 0, len(catalogue))
+	for _, trigger := range catalogue {
+		known[trigger.Name] = true
+		available = append(available, trigger.Name)
+	}
+	sort.Strings(available)
+
+	for name, attrPath := range names {
+		if !known[name] {
+			diags.AddAttributeError(
+				attrPath,
+				"Unknown Shodan trigger",
+				fmt.Sprintf("Trigger %q is not available. Available triggers: %s", name, strings.Join(available, ", ")),
+			)
+		}
+	}
+
+	return diags
+}
+
+// triggersFromAlert builds the triggers set from an alert read from Shodan.
+// Triggers disabled in the prior value aren't present on the alert, so they
+// are carried over unless Shodan reports them as enabled. Ignored services are
+// only read back for triggers whose prior value manages ignore, so services
+// added by shodan_alert_trigger_ignore don't show up as drift.
+func triggersFromAlert(ctx context.Context, alert *AlertResponse, prior types.Set) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorTriggers := map[string]AlertTriggerModel{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var models []AlertTriggerModel
+		diags.Append(prior.ElementsAs(ctx, &models, false)...)
+		for _, model := range models {
+			priorTriggers[model.Name.ValueString()] = model
+		}
+	}
+
+	var elements []attr.Value
+	for name := range alert.Triggers {
+		ignore := types.SetNull(types.StringType)
+		if priorManagesIgnore(priorTriggers[name]) {
+			services, _ := alert.IgnoredServices(name)
+			values := make([]attr.Value, len(services))
+			for i, service := range services {
+				values[i] = types.StringValue(service)
+			}
+			ignore = types.SetValueMust(types.StringType, values)
+		}
+
+		elements = append(elements, types.ObjectValueMust(alertTriggerAttrTypes, map[string]attr.Value{
+			"name":    types.StringValue(name),
+			"enabled": types.BoolValue(true),
+			"ignore":  ignore,
+		}))
+	}
+
+	for name, model := range priorTriggers {
+		if _, ok := alert.Triggers[name]; ok || model.Enabled.ValueBool() {
+			continue
+		}
+
+		element, d := types.ObjectValueFrom(ctx, alertTriggerAttrTypes, model)
+		diags.Append(d...)
+		elements = append(elements, element)
+	}
+
+	if len(elements) == 0 {
+		return types.SetNull(alertTriggerObjectType), diags
+	}
+
+	return types.SetValueMust(alertTriggerObjectType, elements), diags
+}
+
+// priorManagesIgnore reports whether a trigger was configured with an ignore set
+func priorManagesIgnore(model AlertTriggerModel) bool {
+	return !model.Ignore.IsNull() && !model.Ignore.IsUnknown()
+}
+
+// enabledTriggers maps the name of every enabled trigger to its ignored services
+func enabledTriggers(ctx context.Context, triggers types.Set) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	enabled := map[string][]string{}
+	if triggers.IsNull() || triggers.IsUnknown() {
+		return enabled, diags
+	}
+
+	var models []AlertTriggerModel
+	diags.Append(triggers.ElementsAs(ctx, &models, false)...)
+
+	for _, model := range models {
+		if !model.Enabled.IsNull() && !model.Enabled.ValueBool() {
+			continue
+		}
+
+		var services []string
+		if !model.Ignore.IsNull() && !model.Ignore.IsUnknown() {
+			diags.Append(model.Ignore.ElementsAs(ctx, &services, false)...)
+		}
+		enabled[model.Name.ValueString()] = services
+	}
+
+	return enabled, diags
+}
+
+// syncTriggers enables, disables and updates ignored services on an alert so
+// its triggers move from current to desired. Both map trigger names to their
+// ignored services. Every failed call is returned and the remaining changes
+// are still attempted.
+func syncTriggers(client *ShodanClient, alertID string, current, desired map[string][]string) []error {
+	var errs []error
+
+	for _, name := range sortedKeys(current) {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		if err := client.RemoveTrigger(alertID, name); err != nil {
+			errs = append(errs, fmt.Errorf("could not remove trigger %s: %w", name, err))
+		}
+	}
+
+	for _, name := range sortedKeys(desired) {
+		previous, existed := current[name]
+		if !existed {
+			if err := client.AddTrigger(alertID, name); err != nil {
+				errs = append(errs, fmt.Errorf("could not add trigger %s: %w", name, err))
+				continue
+			}
+		}
+
+		for _, service := range desired[name] {
+			if slices.Contains(previous, service) {
+				continue
+			}
+			if err := client.AddTriggerIgnore(alertID, name, service); err != nil {
+				errs = append(errs, fmt.Errorf("could not ignore %s for trigger %s: %w", service, name, err))
+			}
+		}
+
+		for _, service := range previous {
+			if slices.Contains(desired[name], service) {
+				continue
+			}
+			if err := client.RemoveTriggerIgnore(alertID, name, service); err != nil {
+				errs = append(errs, fmt.Errorf("could not stop ignoring %s for trigger %s: %w", service, name, err))
+			}
+		}
+	}
+
+	return errs
+}
+
+// sortedKeys returns the keys of a map in lexical order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,244 @@
+package shodan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakeShodan records the requests made to it and fails those in failing,
+// which maps "METHOD path" to the status to return
+type fakeShodan struct {
+	mu       sync.Mutex
+	requests []string
+	failing  map[string]int
+	handler  http.HandlerFunc
+}
+
+// newTestClient returns a client for a fake Shodan API without rate limiting
+func newTestClient(t *testing.T, fake *fakeShodan) *ShodanClient {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := r.Method + " " + r.URL.Path
+
+		fake.mu.Lock()
+		fake.requests = append(fake.requests, request)
+		status, failing := fake.failing[request]
+		fake.mu.Unlock()
+
+		if failing {
+			http.Error(w, `{"error":"failed"}`, status)
+			return
+		}
+		if fake.handler != nil {
+			fake.handler(w, r)
+			return
+		}
+		w.Write([]byte(`{"success":true}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := NewShodanClient("test")
+	client.BaseURL = srv.URL
+	client.HTTPClient = &RateLimitedHTTPClient{client: srv.Client()}
+	return client
+}
+
+// triggerValue builds a trigger object, with a null ignore set if ignore is nil
+func triggerValue(name string, enabled bool, ignore []string) attr.Value {
+	ignoreSet := types.SetNull(types.StringType)
+	if ignore != nil {
+		values := make([]attr.Value, len(ignore))
+		for i, service := range ignore {
+			values[i] = types.StringValue(service)
+		}
+		ignoreSet = types.SetValueMust(types.StringType, values)
+	}
+
+	return types.ObjectValueMust(alertTriggerAttrTypes, map[string]attr.Value{
+		"name":    types.StringValue(name),
+		"enabled": types.BoolValue(enabled),
+		"ignore":  ignoreSet,
+	})
+}
+
+func TestTriggersFromAlert(t *testing.T) {
+	tests := []struct {
+		name     string
+		triggers map[string]interface{}
+		prior    types.Set
+		want     types.Set
+	}{
+		{
+			name:  "no triggers",
+			prior: types.SetNull(alertTriggerObjectType),
+			want:  types.SetNull(alertTriggerObjectType),
+		},
+		{
+			name: "ignored services are read back when managed",
+			triggers: map[string]interface{}{
+				"malware": map[string]interface{}{},
+				"open_database": map[string]interface{}{
+					"ignore": []interface{}{"198.51.100.1:27017", map[string]interface{}{"ip": "198.51.100.2", "port": float64(9200)}},
+				},
+			},
+			prior: types.SetValueMust(alertTriggerObjectType, []attr.Value{
+				triggerValue("malware", true, nil),
+				triggerValue("open_database", true, []string{"198.51.100.1:27017"}),
+			}),
+			want: types.SetValueMust(alertTriggerObjectType, []attr.Value{
+				triggerValue("malware", true, nil),
+				triggerValue("open_database", true, []string{"198.51.100.1:27017", "198.51.100.2:9200"}),
+			}),
+		},
+		{
+			name: "unmanaged ignored services are left out",
+			triggers: map[string]interface{}{
+				"open_database": map[string]interface{}{"ignore": []interface{}{"198.51.100.1:27017"}},
+			},
+			prior: types.SetNull(alertTriggerObjectType),
+			want:  types.SetValueMust(alertTriggerObjectType, []attr.Value{triggerValue("open_database", true, nil)}),
+		},
+		{
+			name:     "disabled triggers are carried over",
+			triggers: map[string]interface{}{"malware": map[string]interface{}{}},
+			prior: types.SetValueMust(alertTriggerObjectType, []attr.Value{
+				triggerValue("malware", true, nil),
+				triggerValue("iot", false, []string{"198.51.100.1:80"}),
+			}),
+			want: types.SetValueMust(alertTriggerObjectType, []attr.Value{
+				triggerValue("malware", true, nil),
+				triggerValue("iot", false, []string{"198.51.100.1:80"}),
+			}),
+		},
+		{
+			name:     "disabled triggers enabled outside Terraform",
+			triggers: map[string]interface{}{"iot": map[string]interface{}{}},
+			prior:    types.SetValueMust(alertTriggerObjectType, []attr.Value{triggerValue("iot", false, nil)}),
+			want:     types.SetValueMust(alertTriggerObjectType, []attr.Value{triggerValue("iot", true, nil)}),
+		},
+		{
+			name:  "enabled triggers removed outside Terraform",
+			prior: types.SetValueMust(alertTriggerObjectType, []attr.Value{triggerValue("iot", true, nil)}),
+			want:  types.SetNull(alertTriggerObjectType),
+		},
+		{
+			name:     "explicitly empty ignore is kept",
+			triggers: map[string]interface{}{"malware": map[string]interface{}{}},
+			prior:    types.SetValueMust(alertTriggerObjectType, []attr.Value{triggerValue("malware", true, []string{})}),
+			want:     types.SetValueMust(alertTriggerObjectType, []attr.Value{triggerValue("malware", true, []string{})}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alert := &AlertResponse{ID: "A1", Triggers: tt.triggers}
+
+			got, diags := triggersFromAlert(context.Background(), alert, tt.prior)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnabledTriggers(t *testing.T) {
+	triggers := types.SetValueMust(alertTriggerObjectType, []attr.Value{
+		triggerValue("malware", true, nil),
+		triggerValue("iot", false, []string{"198.51.100.1:80"}),
+		triggerValue("open_database", true, []string{"198.51.100.1:27017"}),
+		types.ObjectValueMust(alertTriggerAttrTypes, map[string]attr.Value{
+			"name":    types.StringValue("uncommon"),
+			"enabled": types.BoolNull(),
+			"ignore":  types.SetNull(types.StringType),
+		}),
+	})
+
+	got, diags := enabledTriggers(context.Background(), triggers)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := map[string][]string{
+		"malware":       nil,
+		"open_database": {"198.51.100.1:27017"},
+		"uncommon":      nil,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got, _ := enabledTriggers(context.Background(), types.SetNull(alertTriggerObjectType)); len(got) != 0 {
+		t.Errorf("null triggers gave %v, want none", got)
+	}
+}
+
+func TestSyncTriggers(t *testing.T) {
+	tests := []struct {
+		name         string
+		current      map[string][]string
+		desired      map[string][]string
+		failing      map[string]int
+		wantRequests []string
+		wantErrs     int
+	}{
+		{
+			name:    "no changes",
+			current: map[string][]string{"malware": {"198.51.100.1:80"}},
+			desired: map[string][]string{"malware": {"198.51.100.1:80"}},
+		},
+		{
+			name:    "enable, disable and change ignored services",
+			current: map[string][]string{"iot": nil, "malware": {"198.51.100.1:80"}},
+			desired: map[string][]string{"malware": {"198.51.100.2:80"}, "open_database": {"198.51.100.1:27017"}},
+			wantRequests: []string{
+				"DELETE /shodan/alert/A1/trigger/iot",
+				"PUT /shodan/alert/A1/trigger/malware/ignore/198.51.100.2:80",
+				"DELETE /shodan/alert/A1/trigger/malware/ignore/198.51.100.1:80",
+				"PUT /shodan/alert/A1/trigger/open_database",
+				"PUT /shodan/alert/A1/trigger/open_database/ignore/198.51.100.1:27017",
+			},
+		},
+		{
+			name:    "failures don't stop the remaining changes",
+			current: map[string][]string{"iot": nil},
+			desired: map[string][]string{"malware": {"198.51.100.1:80"}, "open_database": nil},
+			failing: map[string]int{
+				"DELETE /shodan/alert/A1/trigger/iot":  http.StatusInternalServerError,
+				"PUT /shodan/alert/A1/trigger/malware": http.StatusBadRequest,
+			},
+			wantRequests: []string{
+				"DELETE /shodan/alert/A1/trigger/iot",
+				"PUT /shodan/alert/A1/trigger/malware",
+				"PUT /shodan/alert/A1/trigger/open_database",
+			},
+			wantErrs: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeShodan{failing: tt.failing}
+			client := newTestClient(t, fake)
+
+			errs := syncTriggers(client, "A1", tt.current, tt.desired)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("got %d errors %v, want %d", len(errs), errs, tt.wantErrs)
+			}
+			if !slices.Equal(fake.requests, tt.wantRequests) {
+				t.Errorf("got requests %q, want %q", fake.requests, tt.wantRequests)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = ipAddressValidator{}
+	_ validator.String = serviceValidator{}
+)
+
+// ipAddressValidator validates that a string is an IP address
+type ipAddressValidator struct{}
+
+// IPAddress validates that a string is an IPv4 or IPv6 address
+func IPAddress() validator.String {
+	return ipAddressValidator{}
+}
+
+func (v ipAddressValidator) Description(_ context.Context) string {
+	return "value must be an IPv4 or IPv6 address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := netip.ParseAddr(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP address",
+			fmt.Sprintf("%q is not a valid IPv4 or IPv6 address.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// serviceValidator validates that a string identifies a service as ip:port
+type serviceValidator struct{}
+
+// Service validates that a string identifies a service in the form "ip:port",
+// such as "198.51.100.1:443".
+func Service() validator.String {
+	return serviceValidator{}
+}
+
+func (v serviceValidator) Description(_ context.Context) string {
+	return "value must be a service in the form ip:port"
+}
+
+func (v serviceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v serviceValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !isService(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid service",
+			fmt.Sprintf("%q must be a service in the form ip:port, such as 198.51.100.1:443.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// isService reports whether value is an address and port. IPv6 addresses are
+// accepted with or without brackets, since the port follows the last colon.
+func isService(value string) bool {
+	if addrPort, err := netip.ParseAddrPort(value); err == nil {
+		return addrPort.Port() != 0
+	}
+
+	idx := strings.LastIndex(value, ":")
+	if idx < 0 {
+		return false
+	}
+
+	if _, err := netip.ParseAddr(value[:idx]); err != nil {
+		return false
+	}
+
+	port, err := strconv.ParseUint(value[idx+1:], 10, 16)
+	return err == nil && port != 0
+}
@@ -0,0 +1,60 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIPAddress(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: "198.51.100.1"},
+		{value: "2001:db8::1"},
+		{value: "::ffff:198.51.100.1"},
+		{value: "198.51.100.0/24", wantErr: true},
+		{value: "198.51.100.256", wantErr: true},
+		{value: "example.com", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		diags := validateString(IPAddress(), types.StringValue(tt.value))
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("IPAddress(%q) errors = %v, want error %t", tt.value, diags, tt.wantErr)
+		}
+	}
+
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown()} {
+		if diags := validateString(IPAddress(), value); diags.HasError() {
+			t.Errorf("IPAddress(%s) errors = %v, want none", value, diags)
+		}
+	}
+}
+
+func TestService(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: "198.51.100.1:443"},
+		{value: "[2001:db8::1]:443"},
+		{value: "2001:db8::1:443"},
+		{value: "198.51.100.1:65535"},
+		{value: "198.51.100.1", wantErr: true},
+		{value: "198.51.100.1:0", wantErr: true},
+		{value: "198.51.100.1:65536", wantErr: true},
+		{value: "198.51.100.1:https", wantErr: true},
+		{value: "example.com:443", wantErr: true},
+		{value: ":443", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		diags := validateString(Service(), types.StringValue(tt.value))
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("Service(%q) errors = %v, want error %t", tt.value, diags, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"golang.org/x/net/idna"
+)
+
+// maxDomainLength is the longest domain name DNS allows, in ASCII form
+const maxDomainLength = 253
+
+// domainProfile converts internationalised names to punycode the way a
+// resolver would, rejecting labels that aren't valid in DNS.
+var domainProfile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.VerifyDNSLength(true),
+)
+
+var _ validator.String = domainValidator{}
+
+// domainValidator validates that a string is a domain name
+type domainValidator struct{}
+
+// Domain validates that a string is a bare domain name such as "example.com".
+// Internationalised names must be given in punycode form, since they are
+// resolved and sent to Shodan as written; URLs, wildcards and single-label
+// names are rejected.
+func Domain() validator.String {
+	return domainValidator{}
+}
+
+func (v domainValidator) Description(_ context.Context) string {
+	return "value must be a domain name such as example.com"
+}
+
+func (v domainValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v domainValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateDomain(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid domain", err.Error())
+	}
+}
+
+// validateDomain checks the syntax of a domain name
+func validateDomain(domain string) error {
+	if strings.Contains(domain, "://") || strings.ContainsAny(domain, "/?#@: ") {
+		return fmt.Errorf("%q must be a bare domain name such as example.com, not a URL or host:port", domain)
+	}
+
+	ascii, err := domainProfile.ToASCII(strings.TrimSuffix(domain, "."))
+	if err != nil {
+		return fmt.Errorf("%q is not a valid domain name: %s", domain, err.Error())
+	}
+
+	if strings.ContainsFunc(domain, func(r rune) bool { return r > unicode.MaxASCII }) {
+		return fmt.Errorf("%q must be written in punycode form, use %q", domain, ascii)
+	}
+
+	if len(ascii) > maxDomainLength {
+		return fmt.Errorf("%q is longer than %d characters", domain, maxDomainLength)
+	}
+
+	if !strings.Contains(ascii, ".") {
+		return fmt.Errorf("%q must include a top-level domain, such as example.com", domain)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package validators
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDomain(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: "example.com"},
+		{value: "sub.example.co.uk"},
+		{value: "example.com."},
+		{value: "xn--bcher-kva.example"},
+		{value: "bücher.example", wantErr: true},
+		{value: "BÜCHER.example", wantErr: true},
+		{value: "https://example.com", wantErr: true},
+		{value: "example.com/path", wantErr: true},
+		{value: "example.com:443", wantErr: true},
+		{value: "user@example.com", wantErr: true},
+		{value: "*.example.com", wantErr: true},
+		{value: "localhost", wantErr: true},
+		{value: "exa mple.com", wantErr: true},
+		{value: "-example.com", wantErr: true},
+		{value: strings.Repeat("a", 64) + ".com", wantErr: true},
+		{value: strings.Repeat(strings.Repeat("a", 63)+".", 4) + "com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		diags := validateString(Domain(), types.StringValue(tt.value))
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("Domain(%q) errors = %v, want error %t", tt.value, diags, tt.wantErr)
+		}
+	}
+
+	// Internationalised names are rejected with their punycode form
+	if err := validateDomain("bücher.example"); err == nil || !strings.Contains(err.Error(), "xn--bcher-kva.example") {
+		t.Errorf("validateDomain(%q) = %v, want the punycode form suggested", "bücher.example", err)
+	}
+
+	if diags := validateString(Domain(), types.StringUnknown()); diags.HasError() {
+		t.Errorf("Domain(unknown) errors = %v, want none", diags)
+	}
+}
@@ -0,0 +1,94 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// filterNamePattern matches the name of a search filter, optionally negated
+var filterNamePattern = regexp.MustCompile(`^-?[A-Za-z][A-Za-z0-9_.-]*$`)
+
+var _ validator.String = queryValidator{}
+
+// queryValidator validates the syntax of a Shodan search query
+type queryValidator struct{}
+
+// Query validates the syntax of a Shodan search query, such as
+// `apache country:"United States" port:443`. Only problems that can be found
+// offline are reported; the query is checked against Shodan separately when
+// the provider is configured.
+func Query() validator.String {
+	return queryValidator{}
+}
+
+func (v queryValidator) Description(_ context.Context) string {
+	return "value must be a Shodan search query"
+}
+
+func (v queryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v queryValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateQuery(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Shodan query", err.Error())
+	}
+}
+
+// ValidateQuery checks that a query is not blank, that its quotes are closed
+// and that every filter has a value. These are the checks Query makes.
+func ValidateQuery(query string) error {
+	if strings.TrimSpace(query) == "" {
+		return fmt.Errorf("the query must not be empty or blank")
+	}
+
+	tokens, ok := splitQuery(query)
+	if !ok {
+		return fmt.Errorf("%q has an unterminated quote", query)
+	}
+
+	for _, token := range tokens {
+		name, value, found := strings.Cut(token, ":")
+		if found && filterNamePattern.MatchString(name) && value == "" {
+			return fmt.Errorf("the %q filter in %q has no value", strings.TrimPrefix(name, "-"), query)
+		}
+	}
+
+	return nil
+}
+
+// splitQuery splits a query into whitespace-separated tokens, keeping quoted
+// values together. It reports false if a quote is not closed.
+func splitQuery(query string) ([]string, bool) {
+	var tokens []string
+	var token strings.Builder
+	inQuote := false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+			token.WriteRune(r)
+		case unicode.IsSpace(r) && !inQuote:
+			if token.Len() > 0 {
+				tokens = append(tokens, token.String())
+				token.Reset()
+			}
+		default:
+			token.WriteRune(r)
+		}
+	}
+	if token.Len() > 0 {
+		tokens = append(tokens, token.String())
+	}
+
+	return tokens, !inQuote
+}
@@ -0,0 +1,37 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateQuery(t *testing.T) {
+	tests := []struct {
+		query   string
+		wantErr bool
+	}{
+		{query: "apache"},
+		{query: `apache country:"United States" port:443`},
+		{query: `-port:22 product:nginx`},
+		{query: `title:"a: b"`},
+		{query: "http://example.com"},
+		{query: `"Server: nginx"`},
+		{query: "", wantErr: true},
+		{query: " \t", wantErr: true},
+		{query: `product:"Apache httpd`, wantErr: true},
+		{query: "apache port:", wantErr: true},
+		{query: "-country: apache", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		if err := ValidateQuery(tt.query); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateQuery(%q) = %v, want error %t", tt.query, err, tt.wantErr)
+		}
+
+		diags := validateString(Query(), types.StringValue(tt.query))
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("Query(%q) errors = %v, want error %t", tt.query, diags, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRegex(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: `^www\.`},
+		{value: `(?i)^mail[0-9]*$`},
+		{value: ""},
+		{value: `(unclosed`, wantErr: true},
+		{value: `a{2,1}`, wantErr: true},
+		{value: `(?<=look)behind`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		diags := validateString(Regex(), types.StringValue(tt.value))
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("Regex(%q) errors = %v, want error %t", tt.value, diags, tt.wantErr)
+		}
+	}
+}
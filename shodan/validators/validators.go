@@ -0,0 +1,106 @@
+// Package validators provides schema validators for the values accepted by the
+// Shodan API, so invalid configuration is reported at plan time against the
+// offending attribute instead of failing during apply.
+package validators
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	// MaxAlertNameLength is the longest alert name accepted, in characters
+	MaxAlertNameLength = 255
+
+	// MinRequestInterval and MaxRequestInterval bound the delay between API
+	// requests, in seconds
+	MinRequestInterval = 1
+	MaxRequestInterval = 3600
+
+	// MaxPages is the most pages of results a paginated lookup may fetch
+	MaxPages = 100
+
+	// MaxCVEResults is the most CVEs a single CVEDB search returns
+	MaxCVEResults = 1000
+
+	// MaxQueryTags is the most query directory tags a lookup may return
+	MaxQueryTags = 1000
+)
+
+// DNSRecordTypes are the record types a domain lookup can be filtered by
+var DNSRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "NS", "SOA", "TXT"}
+
+var (
+	notBlankPattern    = regexp.MustCompile(`\S`)
+	notifierIDPattern  = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	triggerNamePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+	cveIDPattern       = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+)
+
+// NotBlank validates that a string contains at least one non-whitespace character
+func NotBlank() validator.String {
+	return stringvalidator.RegexMatches(notBlankPattern, "must not be empty or blank")
+}
+
+// AlertName validates that a string is usable as an alert name
+func AlertName() validator.String {
+	return stringvalidator.All(
+		NotBlank(),
+		stringvalidator.UTF8LengthAtMost(MaxAlertNameLength),
+	)
+}
+
+// NotifierID validates the format of a Shodan notifier ID, such as "default"
+func NotifierID() validator.String {
+	return stringvalidator.RegexMatches(
+		notifierIDPattern,
+		`must be a notifier ID made of letters, digits, "_" and "-", such as "default"`,
+	)
+}
+
+// TriggerName validates the format of a trigger name, such as "malware". The
+// name is checked against the live trigger catalogue separately.
+func TriggerName() validator.String {
+	return stringvalidator.RegexMatches(
+		triggerNamePattern,
+		`must be a trigger name made of lowercase letters, digits and "_", such as "malware"`,
+	)
+}
+
+// RequestInterval validates the delay between API requests in seconds
+func RequestInterval() validator.Int64 {
+	return int64validator.Between(MinRequestInterval, MaxRequestInterval)
+}
+
+// Port validates a TCP or UDP port number
+func Port() validator.Int64 {
+	return int64validator.Between(1, 65535)
+}
+
+// DNSRecordType validates a DNS record type Shodan can filter by, such as "A"
+func DNSRecordType() validator.String {
+	return stringvalidator.OneOf(DNSRecordTypes...)
+}
+
+// Pages validates the number of pages a paginated lookup may fetch
+func Pages() validator.Int64 {
+	return int64validator.Between(1, MaxPages)
+}
+
+// CVEID validates the format of a CVE ID, such as "CVE-2021-44228"
+func CVEID() validator.String {
+	return stringvalidator.RegexMatches(cveIDPattern, `must be a CVE ID such as "CVE-2021-44228"`)
+}
+
+// CVELimit validates the number of results requested from a CVEDB search
+func CVELimit() validator.Int64 {
+	return int64validator.Between(1, MaxCVEResults)
+}
+
+// QueryTags validates the number of query directory tags to return
+func QueryTags() validator.Int64 {
+	return int64validator.Between(1, MaxQueryTags)
+}
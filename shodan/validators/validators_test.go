@@ -0,0 +1,103 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateString runs a string validator against a config value
+func validateString(v validator.String, value types.String) diag.Diagnostics {
+	req := validator.StringRequest{Path: path.Root("test"), ConfigValue: value}
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), req, resp)
+	return resp.Diagnostics
+}
+
+// validateInt64 runs an int64 validator against a config value
+func validateInt64(v validator.Int64, value types.Int64) diag.Diagnostics {
+	req := validator.Int64Request{Path: path.Root("test"), ConfigValue: value}
+	resp := &validator.Int64Response{}
+	v.ValidateInt64(context.Background(), req, resp)
+	return resp.Diagnostics
+}
+
+func TestStringValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     string
+		wantErr   bool
+	}{
+		{name: "NotBlank", validator: NotBlank(), value: "web"},
+		{name: "NotBlank", validator: NotBlank(), value: " \t", wantErr: true},
+		{name: "NotBlank", validator: NotBlank(), value: "", wantErr: true},
+		{name: "AlertName", validator: AlertName(), value: "web servers (prod)"},
+		{name: "AlertName", validator: AlertName(), value: strings.Repeat("é", MaxAlertNameLength)},
+		{name: "AlertName", validator: AlertName(), value: strings.Repeat("a", MaxAlertNameLength+1), wantErr: true},
+		{name: "AlertName", validator: AlertName(), value: " ", wantErr: true},
+		{name: "NotifierID", validator: NotifierID(), value: "default"},
+		{name: "NotifierID", validator: NotifierID(), value: "Ab3_x-Y"},
+		{name: "NotifierID", validator: NotifierID(), value: "not/an/id", wantErr: true},
+		{name: "NotifierID", validator: NotifierID(), value: "", wantErr: true},
+		{name: "TriggerName", validator: TriggerName(), value: "open_database"},
+		{name: "TriggerName", validator: TriggerName(), value: "Malware", wantErr: true},
+		{name: "TriggerName", validator: TriggerName(), value: "open-database", wantErr: true},
+		{name: "DNSRecordType", validator: DNSRecordType(), value: "AAAA"},
+		{name: "DNSRecordType", validator: DNSRecordType(), value: "aaaa", wantErr: true},
+		{name: "DNSRecordType", validator: DNSRecordType(), value: "PTR", wantErr: true},
+		{name: "CVEID", validator: CVEID(), value: "CVE-2021-44228"},
+		{name: "CVEID", validator: CVEID(), value: "CVE-2014-0160"},
+		{name: "CVEID", validator: CVEID(), value: "cve-2021-44228", wantErr: true},
+		{name: "CVEID", validator: CVEID(), value: "CVE-2021-442", wantErr: true},
+		{name: "CVEID", validator: CVEID(), value: "2021-44228", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/"+tt.value, func(t *testing.T) {
+			diags := validateString(tt.validator, types.StringValue(tt.value))
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("%s(%q) errors = %v, want error %t", tt.name, tt.value, diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInt64Validators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.Int64
+		value     int64
+		wantErr   bool
+	}{
+		{name: "RequestInterval", validator: RequestInterval(), value: MinRequestInterval},
+		{name: "RequestInterval", validator: RequestInterval(), value: MaxRequestInterval},
+		{name: "RequestInterval", validator: RequestInterval(), value: 0, wantErr: true},
+		{name: "RequestInterval", validator: RequestInterval(), value: MaxRequestInterval + 1, wantErr: true},
+		{name: "Port", validator: Port(), value: 443},
+		{name: "Port", validator: Port(), value: 0, wantErr: true},
+		{name: "Port", validator: Port(), value: 65536, wantErr: true},
+		{name: "Pages", validator: Pages(), value: 1},
+		{name: "Pages", validator: Pages(), value: MaxPages},
+		{name: "Pages", validator: Pages(), value: 0, wantErr: true},
+		{name: "Pages", validator: Pages(), value: MaxPages + 1, wantErr: true},
+		{name: "CVELimit", validator: CVELimit(), value: MaxCVEResults},
+		{name: "CVELimit", validator: CVELimit(), value: 0, wantErr: true},
+		{name: "CVELimit", validator: CVELimit(), value: MaxCVEResults + 1, wantErr: true},
+		{name: "QueryTags", validator: QueryTags(), value: MaxQueryTags},
+		{name: "QueryTags", validator: QueryTags(), value: 0, wantErr: true},
+		{name: "QueryTags", validator: QueryTags(), value: MaxQueryTags + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		diags := validateInt64(tt.validator, types.Int64Value(tt.value))
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("%s(%d) errors = %v, want error %t", tt.name, tt.value, diags, tt.wantErr)
+		}
+	}
+}
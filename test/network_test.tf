@@ -13,18 +13,18 @@ resource "shodan_alert" "test_alert" {
   ]
   
   triggers = [
-    "end_of_life",
-    "industrial_control_system",
-    "internet_scanner",
-    "iot",
-    "malware",
-    "new_service",
-    "open_database",
-    "ssl_expired",
-    "uncommon",
-    "uncommon_plus",
-    "vulnerable",
-    "vulnerable_unverified"
+    { name = "end_of_life" },
+    { name = "industrial_control_system" },
+    { name = "internet_scanner" },
+    { name = "iot" },
+    { name = "malware" },
+    { name = "new_service" },
+    { name = "open_database" },
+    { name = "ssl_expired" },
+    { name = "uncommon" },
+    { name = "uncommon_plus" },
+    { name = "vulnerable" },
+    { name = "vulnerable_unverified" },
   ]
   
   notifiers = [